@@ -0,0 +1,167 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package adapter is the registry of the policy storage backends the service can create.
+//
+// A backend registers a Factory for each of its drivers from an init function, under the
+// name of its adapter family:
+//
+//	func init() {
+//		adapter.Register("redis", "redis", newRedisAdapter)
+//	}
+//
+// and is compiled into the service binary by importing its package.
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/casbin/casbin/persist"
+)
+
+var errDriverName = errors.New("invalid DriverName")
+
+// Config is the configuration an adapter is created from.
+type Config struct {
+	DriverName    string
+	ConnectString string
+	// Params holds the driver specific settings, see Decode.
+	Params map[string]string
+	// Pool holds the connection pool settings, used by the drivers of database servers.
+	Pool PoolOptions
+	// DataDir confines the files of the file and bolt drivers to a directory, see Path.
+	// An empty DataDir lets them use any path.
+	DataDir string
+}
+
+// Factory creates an adapter from its configuration.
+type Factory func(cfg Config) (persist.Adapter, error)
+
+// Driver identifies a registered driver.
+type Driver struct {
+	AdapterName string
+	DriverName  string
+}
+
+var (
+	mu        sync.RWMutex
+	factories = map[Driver]Factory{}
+	// dsnFamilies holds the adapter families whose connection strings are data source names.
+	dsnFamilies = map[string]bool{}
+)
+
+// Register makes a driver of an adapter family available. It panics if the driver is
+// registered twice or if factory is nil.
+func Register(adapterName string, driverName string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if factory == nil {
+		panic("adapter: Register factory is nil")
+	}
+
+	d := Driver{AdapterName: adapterName, DriverName: driverName}
+	if _, dup := factories[d]; dup {
+		panic(fmt.Sprintf("adapter: Register called twice for %s/%s", adapterName, driverName))
+	}
+	factories[d] = factory
+}
+
+// RegisterDSN marks the connection strings of the drivers of an adapter family as data
+// source names of a database server. Only those may reference secrets, the connection
+// strings of the other drivers hold data, like a policy, returned to the callers.
+func RegisterDSN(adapterName string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	dsnFamilies[adapterName] = true
+}
+
+// IsDSN tells whether the connection string of a driver is a data source name, see RegisterDSN.
+func IsDSN(d Driver) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return dsnFamilies[d.AdapterName]
+}
+
+// Drivers gets the registered drivers, sorted by adapter family and driver name.
+func Drivers() []Driver {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	res := make([]Driver, 0, len(factories))
+	for d := range factories {
+		res = append(res, d)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].AdapterName != res[j].AdapterName {
+			return res[i].AdapterName < res[j].AdapterName
+		}
+		return res[i].DriverName < res[j].DriverName
+	})
+
+	return res
+}
+
+// New creates an adapter with a driver of the adapter family adapterName. If adapterName
+// is empty, the family is the only one that registered cfg.DriverName.
+func New(adapterName string, cfg Config) (persist.Adapter, error) {
+	d, err := Lookup(adapterName, cfg.DriverName)
+	if err != nil {
+		return nil, err
+	}
+
+	mu.RLock()
+	factory := factories[d]
+	mu.RUnlock()
+
+	return factory(cfg)
+}
+
+// Lookup gets the registered driver New uses for adapterName and driverName.
+func Lookup(adapterName string, driverName string) (Driver, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if adapterName != "" {
+		d := Driver{AdapterName: adapterName, DriverName: driverName}
+		if _, ok := factories[d]; !ok {
+			return Driver{}, errDriverName
+		}
+		return d, nil
+	}
+
+	var res Driver
+	var families []string
+	for d := range factories {
+		if d.DriverName == driverName {
+			res = d
+			families = append(families, d.AdapterName)
+		}
+	}
+
+	switch len(families) {
+	case 0:
+		return Driver{}, errDriverName
+	case 1:
+		return res, nil
+	default:
+		sort.Strings(families)
+		return Driver{}, fmt.Errorf("driver %s is provided by the adapters %v, an AdapterName is required", driverName, families)
+	}
+}
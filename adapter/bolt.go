@@ -0,0 +1,277 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/casbin/casbin/model"
+	"github.com/casbin/casbin/persist"
+	bolt "go.etcd.io/bbolt"
+)
+
+// DefaultBoltBucket is the bucket holding the policy when no bucket param is given.
+const DefaultBoltBucket = "casbin_rule"
+
+// DefaultBoltTimeout is how long to wait for the lock on the database file when no
+// timeout param is given, so a file held by another process fails the call.
+const DefaultBoltTimeout = time.Second
+
+var errNullByte = errors.New("policy fields cannot contain a NUL byte")
+
+func init() {
+	Register("bolt", "bolt", func(cfg Config) (persist.Adapter, error) {
+		var c BoltConfig
+		if err := cfg.Decode(&c); err != nil {
+			return nil, err
+		}
+
+		path, err := cfg.Path()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewBoltAdapter(path, c)
+	})
+}
+
+// BoltConfig is the typed configuration of the bolt driver.
+type BoltConfig struct {
+	// Bucket is the bucket holding the policy, DefaultBoltBucket by default.
+	Bucket string `param:"bucket"`
+	// Timeout is how long to wait for the lock on the database file, DefaultBoltTimeout by default.
+	Timeout time.Duration `param:"timeout"`
+}
+
+// BoltAdapter stores the policy in a bbolt database file. Every rule is a key of the
+// bucket, made of its policy type and fields, so rules are added and removed one by
+// one and rules sharing leading fields are loaded with a prefix scan. Every write is
+// a transaction committed to disk before the call returns.
+type BoltAdapter struct {
+	db       *bolt.DB
+	bucket   []byte
+	filtered bool
+}
+
+// NewBoltAdapter opens, or creates, the bbolt database at path.
+func NewBoltAdapter(path string, cfg BoltConfig) (*BoltAdapter, error) {
+	if cfg.Bucket == "" {
+		cfg.Bucket = DefaultBoltBucket
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultBoltTimeout
+	}
+
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: cfg.Timeout})
+	if err != nil {
+		return nil, err
+	}
+
+	a := &BoltAdapter{db: db, bucket: []byte(cfg.Bucket)}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(a.bucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Close closes the database file.
+func (a *BoltAdapter) Close() error {
+	return a.db.Close()
+}
+
+// Ping checks that the database file can still be read.
+func (a *BoltAdapter) Ping(ctx context.Context) error {
+	return a.db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(a.bucket) == nil {
+			return fmt.Errorf("bucket %s not found", a.bucket)
+		}
+		return nil
+	})
+}
+
+// LoadPolicy loads all policy rules from the database.
+func (a *BoltAdapter) LoadPolicy(m model.Model) error {
+	err := a.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(a.bucket).ForEach(func(k, v []byte) error {
+			ptype, rule := decodeRuleKey(k)
+			loadRule(m, ptype, rule)
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	a.filtered = false
+	return nil
+}
+
+// LoadFilteredPolicy loads the policy rules that match the filter, a Filter or a []Filter.
+// The leading non-empty values of a filter starting at the first field are looked up with
+// a prefix scan.
+func (a *BoltAdapter) LoadFilteredPolicy(m model.Model, filter interface{}) error {
+	filters, err := toFilters(filter)
+	if err != nil {
+		return err
+	}
+	if filters == nil {
+		return a.LoadPolicy(m)
+	}
+
+	// A rule matching several filters must be loaded once.
+	loaded := map[string]bool{}
+
+	err = a.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(a.bucket).Cursor()
+
+		for _, f := range filters {
+			prefix := []string{f.PType}
+			if f.FieldIndex == 0 {
+				for _, value := range f.FieldValues {
+					if value == "" {
+						break
+					}
+					prefix = append(prefix, value)
+				}
+			}
+			p := encodeRuleKey(prefix[0], prefix[1:])
+
+			for k, _ := c.Seek(p); k != nil && bytes.HasPrefix(k, p); k, _ = c.Next() {
+				ptype, rule := decodeRuleKey(k)
+				if loaded[string(k)] || !matchFilter(rule, f.FieldIndex, f.FieldValues) {
+					continue
+				}
+
+				loaded[string(k)] = true
+				loadRule(m, ptype, rule)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	a.filtered = true
+	return nil
+}
+
+// IsFiltered determines whether the last load of the policy was filtered.
+func (a *BoltAdapter) IsFiltered() bool {
+	return a.filtered
+}
+
+// SavePolicy replaces the policy rules of the database with those of the model, in a single transaction.
+func (a *BoltAdapter) SavePolicy(m model.Model) error {
+	return a.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(a.bucket); err != nil {
+			return err
+		}
+		b, err := tx.CreateBucket(a.bucket)
+		if err != nil {
+			return err
+		}
+
+		for _, sec := range []string{"p", "g"} {
+			for ptype, ast := range m[sec] {
+				for _, rule := range ast.Policy {
+					if err := putRule(b, ptype, rule); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// AddPolicy adds a policy rule to the database.
+func (a *BoltAdapter) AddPolicy(sec string, ptype string, rule []string) error {
+	return a.db.Update(func(tx *bolt.Tx) error {
+		return putRule(tx.Bucket(a.bucket), ptype, rule)
+	})
+}
+
+// RemovePolicy removes a policy rule from the database.
+func (a *BoltAdapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	return a.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(a.bucket).Delete(encodeRuleKey(ptype, rule))
+	})
+}
+
+// RemoveFilteredPolicy removes the policy rules that match the filter from the database.
+func (a *BoltAdapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	return a.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(a.bucket)
+		p := encodeRuleKey(ptype, nil)
+
+		var keys [][]byte
+		c := b.Cursor()
+		for k, _ := c.Seek(p); k != nil && bytes.HasPrefix(k, p); k, _ = c.Next() {
+			if _, rule := decodeRuleKey(k); matchFilter(rule, fieldIndex, fieldValues) {
+				keys = append(keys, append([]byte(nil), k...))
+			}
+		}
+
+		for _, k := range keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func putRule(b *bolt.Bucket, ptype string, rule []string) error {
+	for _, field := range rule {
+		if strings.IndexByte(field, 0) != -1 {
+			return errNullByte
+		}
+	}
+
+	return b.Put(encodeRuleKey(ptype, rule), []byte{})
+}
+
+// encodeRuleKey terminates the policy type and every field of the rule with a NUL byte,
+// so that the key of a rule starts with the key of any of its leading fields.
+func encodeRuleKey(ptype string, rule []string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(ptype)
+	buf.WriteByte(0)
+	for _, field := range rule {
+		buf.WriteString(field)
+		buf.WriteByte(0)
+	}
+
+	return buf.Bytes()
+}
+
+func decodeRuleKey(k []byte) (string, []string) {
+	fields := strings.Split(strings.TrimSuffix(string(k), "\x00"), "\x00")
+	return fields[0], fields[1:]
+}
@@ -0,0 +1,177 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+var errOutsideDataDir = errors.New("adapter: the path is outside of the data directory")
+
+// Path gets the file named by ConnectString. A relative path is taken from DataDir, and
+// paths leading out of DataDir, also through symbolic links, are rejected.
+func (c Config) Path() (string, error) {
+	if c.DataDir == "" {
+		return c.ConnectString, nil
+	}
+
+	dir, err := realPath(c.DataDir)
+	if err != nil {
+		return "", err
+	}
+
+	path := c.ConnectString
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	path = filepath.Clean(path)
+
+	// The file itself may not exist yet, its directory has to.
+	parent, err := realPath(filepath.Dir(path))
+	if err != nil {
+		return "", err
+	}
+	if !inDir(dir, parent) {
+		return "", errOutsideDataDir
+	}
+	path = filepath.Join(parent, filepath.Base(path))
+
+	fi, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return path, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// A dangling link would be followed when the file is created.
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		if fi.Mode()&os.ModeSymlink != 0 && os.IsNotExist(err) {
+			return "", errOutsideDataDir
+		}
+		return "", err
+	}
+	if !inDir(dir, target) {
+		return "", errOutsideDataDir
+	}
+
+	return path, nil
+}
+
+// inDir tells whether path is dir or one of its descendants.
+func inDir(dir string, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func realPath(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.EvalSymlinks(path)
+}
+
+// Decode copies Params into the fields of the struct pointed to by v. A field receives
+// the param named by its `param` tag; string, bool, integer, float and time.Duration
+// fields are supported. Params matching no field are rejected.
+func (c Config) Decode(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("adapter: Decode needs a pointer to a struct")
+	}
+	rv = rv.Elem()
+
+	used := map[string]bool{}
+	for i := 0; i < rv.NumField(); i++ {
+		name := rv.Type().Field(i).Tag.Get("param")
+		if name == "" {
+			continue
+		}
+
+		value, ok := c.Params[name]
+		if !ok {
+			continue
+		}
+		used[name] = true
+
+		if err := setField(rv.Field(i), value); err != nil {
+			return fmt.Errorf("invalid param %s: %v", name, err)
+		}
+	}
+
+	for name := range c.Params {
+		if !used[name] {
+			return fmt.Errorf("unknown param %s for driver %s", name, c.DriverName)
+		}
+	}
+
+	return nil
+}
+
+func setField(field reflect.Value, value string) error {
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
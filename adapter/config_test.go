@@ -0,0 +1,92 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestConfigPath(t *testing.T) {
+	root, err := ioutil.TempDir("", "adapter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	root, _ = filepath.EvalSymlinks(root)
+
+	dir := filepath.Join(root, "data")
+	outside := filepath.Join(root, "outside")
+	for _, d := range []string{filepath.Join(dir, "sub"), outside} {
+		if err := os.MkdirAll(d, 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink(outside, filepath.Join(dir, "link")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(outside, "policy.csv"), filepath.Join(dir, "policy.csv")); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"rbac.csv", filepath.Join(dir, "rbac.csv")},
+		{"sub/policy.db", filepath.Join(dir, "sub", "policy.db")},
+		{filepath.Join(dir, "sub", "policy.db"), filepath.Join(dir, "sub", "policy.db")},
+		{"../outside/policy.csv", ""},
+		{filepath.Join(outside, "policy.csv"), ""},
+		{"link/policy.csv", ""},
+		{"policy.csv", ""},
+	}
+
+	for _, tt := range tests {
+		got, err := Config{ConnectString: tt.path, DataDir: dir}.Path()
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("%s: got %s, want an error", tt.path, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: got %s %v, want %s", tt.path, got, err, tt.want)
+		}
+	}
+
+	if got, err := (Config{ConnectString: "../any.csv"}).Path(); err != nil || got != "../any.csv" {
+		t.Errorf("without DataDir: got %s %v", got, err)
+	}
+}
+
+func TestConfigDecode(t *testing.T) {
+	var c BoltConfig
+	cfg := Config{DriverName: "bolt", Params: map[string]string{"bucket": "rules", "timeout": "5s"}}
+	if err := cfg.Decode(&c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Bucket != "rules" || c.Timeout != 5*time.Second {
+		t.Errorf("got %+v", c)
+	}
+
+	cfg.Params = map[string]string{"unknown": "1"}
+	if err := cfg.Decode(&c); err == nil {
+		t.Error("unknown param accepted")
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"fmt"
+
+	"github.com/casbin/casbin/model"
+)
+
+// Filter selects the rules of a policy type whose fields starting at FieldIndex equal
+// FieldValues, an empty value matching any field. It is passed to LoadFilteredPolicy
+// alone, or as a []Filter to load the rules matching any of the filters.
+type Filter struct {
+	PType       string
+	FieldIndex  int
+	FieldValues []string
+}
+
+func toFilters(filter interface{}) ([]Filter, error) {
+	switch f := filter.(type) {
+	case nil:
+		return nil, nil
+	case Filter:
+		return []Filter{f}, nil
+	case *Filter:
+		if f == nil {
+			return nil, nil
+		}
+		return []Filter{*f}, nil
+	case []Filter:
+		return f, nil
+	default:
+		return nil, fmt.Errorf("invalid filter type %T", filter)
+	}
+}
+
+func matchFilters(filters []Filter, ptype string, rule []string) bool {
+	for _, f := range filters {
+		if f.PType == ptype && matchFilter(rule, f.FieldIndex, f.FieldValues) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchFilter determines whether the fields of rule starting at fieldIndex equal fieldValues,
+// an empty value matching any field.
+func matchFilter(rule []string, fieldIndex int, fieldValues []string) bool {
+	for i, value := range fieldValues {
+		if value == "" {
+			continue
+		}
+		if fieldIndex+i >= len(rule) || rule[fieldIndex+i] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalRule(rule1 []string, rule2 []string) bool {
+	if len(rule1) != len(rule2) {
+		return false
+	}
+	for i := range rule1 {
+		if rule1[i] != rule2[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// loadRule adds a rule to the model, unless the model does not define its policy type.
+func loadRule(m model.Model, ptype string, rule []string) {
+	if ptype == "" {
+		return
+	}
+	if ast, ok := m[ptype[:1]][ptype]; ok {
+		ast.Policy = append(ast.Policy, append([]string(nil), rule...))
+	}
+}
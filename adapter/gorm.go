@@ -0,0 +1,153 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/casbin/casbin/persist"
+	"github.com/casbin/gorm-adapter"
+	"github.com/jinzhu/gorm"
+)
+
+func init() {
+	RegisterDSN("gorm")
+}
+
+// GormConfig is the typed configuration of the gorm drivers.
+type GormConfig struct {
+	// DBSpecified tells that ConnectString names the database to use,
+	// otherwise the adapter creates and uses the "casbin" database.
+	DBSpecified bool `param:"db_specified"`
+}
+
+// GormAdapter is the adapter of github.com/casbin/gorm-adapter over a connection pool
+// set up from the PoolOptions of the adapter Config.
+type GormAdapter struct {
+	*gormadapter.Adapter
+
+	db   *gorm.DB
+	pool PoolOptions
+}
+
+// newGormAdapter creates a gorm adapter. The SQL dialects are registered by the
+// gorm_*.go files, each of which can be left out of the build with a "no<dialect>" tag.
+func newGormAdapter(cfg Config) (persist.Adapter, error) {
+	var c GormConfig
+	if err := cfg.Decode(&c); err != nil {
+		return nil, err
+	}
+
+	return NewGormAdapter(cfg.DriverName, cfg.ConnectString, c.DBSpecified || cfg.DriverName == "sqlite3", cfg.Pool)
+}
+
+// NewGormAdapter connects to the database and creates the casbin_rule table if needed.
+// Unless dbSpecified is set, the "casbin" database is created and used.
+func NewGormAdapter(driverName string, dataSourceName string, dbSpecified bool, pool PoolOptions) (*GormAdapter, error) {
+	if !dbSpecified {
+		if err := createDatabase(driverName, dataSourceName, pool); err != nil {
+			return nil, err
+		}
+
+		if driverName == "postgres" {
+			dataSourceName += " dbname=casbin"
+		} else {
+			dataSourceName += "casbin"
+		}
+	}
+
+	db, err := openGorm(driverName, dataSourceName, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := newUpstreamAdapter(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &GormAdapter{Adapter: a, db: db, pool: pool}, nil
+}
+
+// newUpstreamAdapter creates the casbin_rule table through gorm-adapter, which panics
+// when it fails.
+func newUpstreamAdapter(db *gorm.DB) (a *gormadapter.Adapter, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("gorm adapter: %v", r)
+		}
+	}()
+
+	return gormadapter.NewAdapterByDB(db), nil
+}
+
+// openGorm opens the connection pool and checks that the database can be reached
+// within the Timeout of the pool options.
+func openGorm(driverName string, dataSourceName string, pool PoolOptions) (*gorm.DB, error) {
+	sqlDB, err := sql.Open(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	pool.apply(sqlDB)
+
+	ctx, cancel := pool.context(context.Background())
+	defer cancel()
+	if err := sqlDB.PingContext(ctx); err != nil {
+		sqlDB.Close()
+		return nil, err
+	}
+
+	db, err := gorm.Open(driverName, sqlDB)
+	if err != nil {
+		sqlDB.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+func createDatabase(driverName string, dataSourceName string, pool PoolOptions) error {
+	db, err := openGorm(driverName, dataSourceName, pool)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if driverName == "postgres" {
+		err = db.Exec("CREATE DATABASE casbin").Error
+		if err != nil && strings.Contains(err.Error(), "already exists") {
+			return nil
+		}
+		return err
+	}
+
+	return db.Exec("CREATE DATABASE IF NOT EXISTS casbin").Error
+}
+
+// Close closes the connection pool.
+func (a *GormAdapter) Close() error {
+	return a.db.Close()
+}
+
+// Ping checks that the database can be reached, waiting at most the Timeout of the pool options.
+func (a *GormAdapter) Ping(ctx context.Context) error {
+	ctx, cancel := a.pool.context(ctx)
+	defer cancel()
+
+	return a.db.DB().PingContext(ctx)
+}
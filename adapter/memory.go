@@ -0,0 +1,182 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/casbin/casbin/model"
+	"github.com/casbin/casbin/persist"
+)
+
+func init() {
+	Register("memory", "memory", func(cfg Config) (persist.Adapter, error) {
+		return NewMemoryAdapter(), nil
+	})
+	Register("memory", "text", func(cfg Config) (persist.Adapter, error) {
+		return NewTextAdapter(cfg.ConnectString)
+	})
+}
+
+// line is a rule of the policy together with its policy type.
+type line struct {
+	ptype string
+	rule  []string
+}
+
+// MemoryAdapter keeps the policy in the process, it backs the "memory" and "text" drivers.
+type MemoryAdapter struct {
+	mu       sync.RWMutex
+	lines    []line
+	filtered bool
+}
+
+// NewMemoryAdapter creates an adapter holding an empty policy.
+func NewMemoryAdapter() *MemoryAdapter {
+	return &MemoryAdapter{}
+}
+
+// NewTextAdapter creates an adapter holding the policy given as Casbin CSV text.
+func NewTextAdapter(text string) (*MemoryAdapter, error) {
+	a := &MemoryAdapter{}
+
+	r := csv.NewReader(strings.NewReader(text))
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 2 || record[0] == "" {
+			return nil, fmt.Errorf("rule %d: a rule needs a policy type and at least one field", len(a.lines)+1)
+		}
+
+		a.lines = append(a.lines, line{ptype: record[0], rule: record[1:]})
+	}
+
+	return a, nil
+}
+
+// LoadPolicy loads all policy rules from the adapter.
+func (a *MemoryAdapter) LoadPolicy(m model.Model) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, l := range a.lines {
+		loadRule(m, l.ptype, l.rule)
+	}
+	a.filtered = false
+
+	return nil
+}
+
+// LoadFilteredPolicy loads the policy rules that match the filter, a Filter or a []Filter.
+func (a *MemoryAdapter) LoadFilteredPolicy(m model.Model, filter interface{}) error {
+	filters, err := toFilters(filter)
+	if err != nil {
+		return err
+	}
+	if filters == nil {
+		return a.LoadPolicy(m)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, l := range a.lines {
+		if matchFilters(filters, l.ptype, l.rule) {
+			loadRule(m, l.ptype, l.rule)
+		}
+	}
+	a.filtered = true
+
+	return nil
+}
+
+// IsFiltered determines whether the last load of the policy was filtered.
+func (a *MemoryAdapter) IsFiltered() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.filtered
+}
+
+// SavePolicy replaces the policy rules of the adapter with those of the model.
+func (a *MemoryAdapter) SavePolicy(m model.Model) error {
+	var lines []line
+	for _, sec := range []string{"p", "g"} {
+		for ptype, ast := range m[sec] {
+			for _, rule := range ast.Policy {
+				lines = append(lines, line{ptype: ptype, rule: append([]string(nil), rule...)})
+			}
+		}
+	}
+
+	a.mu.Lock()
+	a.lines = lines
+	a.mu.Unlock()
+
+	return nil
+}
+
+// AddPolicy adds a policy rule to the adapter.
+func (a *MemoryAdapter) AddPolicy(sec string, ptype string, rule []string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.lines = append(a.lines, line{ptype: ptype, rule: append([]string(nil), rule...)})
+	return nil
+}
+
+// RemovePolicy removes a policy rule from the adapter.
+func (a *MemoryAdapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for i, l := range a.lines {
+		if l.ptype == ptype && equalRule(l.rule, rule) {
+			a.lines = append(a.lines[:i], a.lines[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+// RemoveFilteredPolicy removes the policy rules that match the filter from the adapter.
+func (a *MemoryAdapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	lines := a.lines[:0]
+	for _, l := range a.lines {
+		if l.ptype != ptype || !matchFilter(l.rule, fieldIndex, fieldValues) {
+			lines = append(lines, l)
+		}
+	}
+	a.lines = lines
+
+	return nil
+}
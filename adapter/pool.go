@@ -0,0 +1,67 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/casbin/casbin/persist"
+)
+
+// PoolOptions are the connection pool settings of the adapters backed by a database
+// server, the zero value of a field keeps the driver default.
+type PoolOptions struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	// Timeout bounds the first connection to the backend and every health check.
+	Timeout time.Duration
+}
+
+func (o PoolOptions) apply(db *sql.DB) {
+	if o.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(o.MaxOpenConns)
+	}
+	if o.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(o.MaxIdleConns)
+	}
+	if o.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(o.ConnMaxLifetime)
+	}
+}
+
+func (o PoolOptions) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if o.Timeout > 0 {
+		return context.WithTimeout(ctx, o.Timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// Pinger is implemented by the adapters whose backend can become unreachable.
+type Pinger interface {
+	// Ping checks that the backend can serve the policy.
+	Ping(ctx context.Context) error
+}
+
+// Ping checks the backend of an adapter. Adapters that do not implement Pinger are always healthy.
+func Ping(ctx context.Context, a persist.Adapter) error {
+	if p, ok := a.(Pinger); ok {
+		return p.Ping(ctx)
+	}
+
+	return nil
+}
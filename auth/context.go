@@ -0,0 +1,82 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth identifies the callers of the service and guards its RPCs.
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/micro/go-micro/metadata"
+)
+
+// DefaultPrincipalKey is the metadata key naming the caller when the caller is trusted
+// to identify itself.
+const DefaultPrincipalKey = "X-Casbin-Principal"
+
+// Principal is the authenticated caller of an RPC.
+type Principal struct {
+	// Subject identifies the caller.
+	Subject string
+	// Claims holds the claims of the token the caller was authenticated with, if any.
+	Claims map[string]interface{}
+}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying p.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext gets the principal authenticated for the RPC of ctx.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// Subject gets the caller of the RPC of ctx: the authenticated principal or, unless key
+// is empty, the metadata key the caller identifies itself with.
+func Subject(ctx context.Context, key string) string {
+	if p, ok := FromContext(ctx); ok {
+		return p.Subject
+	}
+	if key == "" {
+		return ""
+	}
+
+	sub, _ := metadataValue(ctx, key)
+	return sub
+}
+
+// metadataValue looks up a metadata key regardless of its case, the transports
+// not agreeing on the case of header names.
+func metadataValue(ctx context.Context, key string) (string, bool) {
+	md, ok := metadata.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	if value, ok := md[key]; ok {
+		return value, true
+	}
+	for k, value := range md {
+		if strings.EqualFold(k, key) {
+			return value, true
+		}
+	}
+
+	return "", false
+}
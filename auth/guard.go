@@ -0,0 +1,222 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/casbin/casbin"
+	"github.com/casbin/casbin/model"
+	"github.com/casbin/casbin/persist/file-adapter"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/server"
+)
+
+// DefaultMetaModel is the model of the meta policy used when none is configured. A rule
+// p, sub, obj, act lets sub call the RPCs matching act on the enforcer obj: obj is an
+// enforcer handle, "service" for the RPCs not bound to an enforcer, both prefixed by the
+// tenant of the caller in a multi-tenant service, e.g. "acme/1" or "acme/service", act is
+// the RPC name prefixed by its class, e.g. "write:AddPolicy". Both may end with "*".
+const DefaultMetaModel = `
+[request_definition]
+r = sub, obj, act
+
+[policy_definition]
+p = sub, obj, act
+
+[role_definition]
+g = _, _
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = g(r.sub, p.sub) && keyMatch(r.obj, p.obj) && keyMatch(r.act, p.act)
+`
+
+// ServiceObject is the object of the RPCs that are not bound to an enforcer.
+const ServiceObject = "service"
+
+// Class groups the RPCs by what they do to the enforcers.
+type Class string
+
+const (
+	// Read is the class of the RPCs only reading an enforcer.
+	Read Class = "read"
+	// Enforce is the class of the Enforce RPC.
+	Enforce Class = "enforce"
+	// Write is the class of the RPCs creating or changing an enforcer or an adapter.
+	Write Class = "write"
+)
+
+var readPrefixes = []string{"Get", "Has", "List", "WhoCan", "Export", "Analyze", "Check", "Health", "Readiness", "Version"}
+
+var serviceMethods = map[string]bool{
+	"NewEnforcer":        true,
+	"NewAdapter":         true,
+	"ListModelTemplates": true,
+	"ListAdapterDrivers": true,
+	"CheckAdapter":       true,
+	"Health":             true,
+	"Readiness":          true,
+	"Version":            true,
+}
+
+// ClassOf gets the class of an RPC from its name, any RPC not known to only read is a write.
+func ClassOf(method string) Class {
+	if method == "Enforce" {
+		return Enforce
+	}
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return Read
+		}
+	}
+
+	return Write
+}
+
+// GuardOptions configures the meta-enforcer guard.
+type GuardOptions struct {
+	// CheckReads guards the read RPCs, otherwise anyone can call them.
+	CheckReads bool
+	// CheckEnforce guards the Enforce RPC, otherwise anyone can call it.
+	CheckEnforce bool
+	// PrincipalKey is the metadata key naming the caller when no authenticated principal
+	// is in the context. Empty means callers must be authenticated.
+	PrincipalKey string
+}
+
+// GuardOption sets an option of the guard.
+type GuardOption func(*GuardOptions)
+
+// CheckReads sets whether the read RPCs are guarded.
+func CheckReads(b bool) GuardOption {
+	return func(o *GuardOptions) {
+		o.CheckReads = b
+	}
+}
+
+// CheckEnforce sets whether the Enforce RPC is guarded.
+func CheckEnforce(b bool) GuardOption {
+	return func(o *GuardOptions) {
+		o.CheckEnforce = b
+	}
+}
+
+// PrincipalKey sets the metadata key trusted to name the caller, empty to only trust
+// authenticated principals.
+func PrincipalKey(key string) GuardOption {
+	return func(o *GuardOptions) {
+		o.PrincipalKey = key
+	}
+}
+
+// MetaGuard is a handler wrapper asking the meta enforcer e whether the caller may call
+// the RPC on its enforcer. Denied calls fail with a Forbidden error, calls from unknown
+// callers with an Unauthorized error.
+func MetaGuard(e *casbin.Enforcer, opts ...GuardOption) server.HandlerWrapper {
+	options := GuardOptions{PrincipalKey: DefaultPrincipalKey}
+	for _, o := range opts {
+		o(&options)
+	}
+
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			method := req.Method()
+			if i := strings.LastIndex(method, "."); i != -1 {
+				method = method[i+1:]
+			}
+
+			class := ClassOf(method)
+			if (class == Read && !options.CheckReads) || (class == Enforce && !options.CheckEnforce) {
+				return fn(ctx, req, rsp)
+			}
+
+			sub := Subject(ctx, options.PrincipalKey)
+			if sub == "" {
+				return errors.Unauthorized(req.Service(), "no principal for %s", method)
+			}
+
+			obj := object(ctx, method, req.Request())
+			act := string(class) + ":" + method
+			allowed, err := e.EnforceSafe(sub, obj, act)
+			if err != nil {
+				return errors.InternalServerError(req.Service(), "meta policy: %v", err)
+			}
+			if !allowed {
+				return errors.Forbidden(req.Service(), "%s may not call %s on %s", sub, method, obj)
+			}
+
+			return fn(ctx, req, rsp)
+		}
+	}
+}
+
+// object gets the enforcer handle an RPC is called on, prefixed by the tenant of the caller
+// since handles are only unique within a tenant.
+func object(ctx context.Context, method string, req interface{}) string {
+	obj := ServiceObject
+	if !serviceMethods[method] {
+		switch r := req.(type) {
+		case interface{ GetEnforcerHandler() int32 }:
+			obj = strconv.Itoa(int(r.GetEnforcerHandler()))
+		case interface{ GetHandler() int32 }:
+			obj = strconv.Itoa(int(r.GetHandler()))
+		}
+	}
+
+	if tenant := TenantFromContext(ctx); tenant != "" {
+		return tenant + "/" + obj
+	}
+	return obj
+}
+
+// NewMetaEnforcer creates the meta enforcer from the policy CSV file at policyPath and the
+// model file at modelPath, DefaultMetaModel if modelPath is empty.
+func NewMetaEnforcer(modelPath string, policyPath string) (*casbin.Enforcer, error) {
+	text := DefaultMetaModel
+	if modelPath != "" {
+		data, err := ioutil.ReadFile(modelPath)
+		if err != nil {
+			return nil, err
+		}
+		text = string(data)
+	}
+
+	m, err := newModel(text)
+	if err != nil {
+		return nil, fmt.Errorf("meta model: %v", err)
+	}
+
+	return casbin.NewEnforcerSafe(m, fileadapter.NewAdapter(policyPath))
+}
+
+// newModel parses a model, casbin panics on invalid models.
+func newModel(text string) (m model.Model, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	m = casbin.NewModel()
+	m.LoadModelFromText(text)
+	return m, nil
+}
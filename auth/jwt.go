@@ -0,0 +1,179 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/server"
+)
+
+// JWTOptions configures the JWT authentication.
+type JWTOptions struct {
+	// Required rejects the calls without a token, otherwise they go on unauthenticated.
+	Required bool
+	// Issuer, if set, must be the iss claim of the tokens.
+	Issuer string
+	// Audience, if set, must be in the aud claim of the tokens.
+	Audience string
+	// SubjectClaim is the claim naming the caller, "sub" by default.
+	SubjectClaim string
+}
+
+// JWTOption sets an option of the JWT authentication.
+type JWTOption func(*JWTOptions)
+
+// Required sets whether calls without a token are rejected.
+func Required(b bool) JWTOption {
+	return func(o *JWTOptions) {
+		o.Required = b
+	}
+}
+
+// Issuer sets the issuer the tokens must come from.
+func Issuer(iss string) JWTOption {
+	return func(o *JWTOptions) {
+		o.Issuer = iss
+	}
+}
+
+// Audience sets the audience the tokens must be issued for.
+func Audience(aud string) JWTOption {
+	return func(o *JWTOptions) {
+		o.Audience = aud
+	}
+}
+
+// SubjectClaim sets the claim naming the caller.
+func SubjectClaim(claim string) JWTOption {
+	return func(o *JWTOptions) {
+		o.SubjectClaim = claim
+	}
+}
+
+// JWT is a handler wrapper authenticating the callers with the bearer token of the
+// Authorization metadata. The token must be signed by a key of keys and be valid at
+// the time of the call; its subject and claims are then put in the context of the
+// call, see FromContext. Invalid tokens fail with an Unauthorized error.
+func JWT(keys *KeySet, opts ...JWTOption) server.HandlerWrapper {
+	options := JWTOptions{SubjectClaim: "sub"}
+	for _, o := range opts {
+		o(&options)
+	}
+
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			raw, ok := bearerToken(ctx)
+			if !ok {
+				if options.Required {
+					return errors.Unauthorized(req.Service(), "missing bearer token")
+				}
+				return fn(ctx, req, rsp)
+			}
+
+			p, err := verify(keys, raw, options)
+			if err != nil {
+				return errors.Unauthorized(req.Service(), "invalid token: %v", err)
+			}
+
+			return fn(NewContext(ctx, p), req, rsp)
+		}
+	}
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	value, ok := metadataValue(ctx, "Authorization")
+	if !ok || len(value) < 7 || !strings.EqualFold(value[:7], "Bearer ") {
+		return "", false
+	}
+
+	return strings.TrimSpace(value[7:]), true
+}
+
+// Verify checks the token raw like the JWT wrapper does, e.g. for HTTP requests, and
+// returns the principal it authenticates. The Required option does not apply.
+func Verify(keys *KeySet, raw string, opts ...JWTOption) (*Principal, error) {
+	options := JWTOptions{SubjectClaim: "sub"}
+	for _, o := range opts {
+		o(&options)
+	}
+
+	return verify(keys, raw, options)
+}
+
+func verify(keys *KeySet, raw string, options JWTOptions) (*Principal, error) {
+	unverified, _, err := new(jwt.Parser).ParseUnverified(raw, jwt.MapClaims{})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := keys.candidates(unverified)
+	if len(candidates) == 0 {
+		return nil, errNoKey
+	}
+
+	var token *jwt.Token
+	for _, key := range candidates {
+		key := key
+		token, err = jwt.Parse(raw, func(*jwt.Token) (interface{}, error) {
+			return key, nil
+		})
+		if err == nil {
+			break
+		}
+		// Only a wrong signature is worth trying the next key.
+		if e, ok := err.(*jwt.ValidationError); !ok || e.Errors&jwt.ValidationErrorSignatureInvalid == 0 {
+			return nil, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	claims := token.Claims.(jwt.MapClaims)
+	if options.Issuer != "" && !claims.VerifyIssuer(options.Issuer, true) {
+		return nil, fmt.Errorf("issuer is not %s", options.Issuer)
+	}
+	if options.Audience != "" && !verifyAudience(claims, options.Audience) {
+		return nil, fmt.Errorf("audience is not %s", options.Audience)
+	}
+
+	sub, _ := claims[options.SubjectClaim].(string)
+	if sub == "" {
+		return nil, fmt.Errorf("no %s claim", options.SubjectClaim)
+	}
+
+	return &Principal{Subject: sub, Claims: claims}, nil
+}
+
+// verifyAudience checks the aud claim, which may be a string or an array of strings.
+func verifyAudience(claims jwt.MapClaims, aud string) bool {
+	switch v := claims["aud"].(type) {
+	case string:
+		return v == aud
+	case []interface{}:
+		for _, a := range v {
+			if s, ok := a.(string); ok && s == aud {
+				return true
+			}
+		}
+	}
+
+	return false
+}
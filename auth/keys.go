@@ -0,0 +1,202 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+var errNoKey = errors.New("no key to verify the token")
+
+// KeySet holds the keys tokens are verified with. A token naming a key id in its kid
+// header is verified with that key only, other tokens with every key matching their
+// algorithm.
+type KeySet struct {
+	hmac  [][]byte
+	keys  []interface{}
+	byKid map[string]interface{}
+}
+
+// NewKeySet creates an empty key set.
+func NewKeySet() *KeySet {
+	return &KeySet{byKid: map[string]interface{}{}}
+}
+
+// AddHMAC adds a secret verifying the HS256, HS384 and HS512 tokens.
+func (ks *KeySet) AddHMAC(secret []byte) {
+	ks.hmac = append(ks.hmac, secret)
+}
+
+// AddPEMFile adds the RSA or ECDSA public keys, or certificates, of a PEM file.
+func (ks *KeySet) AddPEMFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(data); err == nil {
+		ks.keys = append(ks.keys, key)
+		return nil
+	}
+	if key, err := jwt.ParseECPublicKeyFromPEM(data); err == nil {
+		ks.keys = append(ks.keys, key)
+		return nil
+	}
+
+	return fmt.Errorf("%s: no RSA or ECDSA public key found", path)
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	K   string `json:"k"`
+}
+
+// AddJWKSFile adds the RSA, EC and oct keys of a JSON Web Key Set file.
+// Keys meant for encryption are skipped.
+func (ks *KeySet) AddJWKSFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if err != nil {
+			return fmt.Errorf("%s: key %d: %v", path, i, err)
+		}
+
+		if k.Kid != "" {
+			ks.byKid[k.Kid] = key
+		} else if secret, ok := key.([]byte); ok {
+			ks.hmac = append(ks.hmac, secret)
+		} else {
+			ks.keys = append(ks.keys, key)
+		}
+	}
+
+	return nil
+}
+
+func (k jwk) publicKey() (interface{}, error) {
+	decode := base64.RawURLEncoding.DecodeString
+
+	switch k.Kty {
+	case "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "oct":
+		return decode(k.K)
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+// Empty determines whether the set holds no key.
+func (ks *KeySet) Empty() bool {
+	return len(ks.hmac) == 0 && len(ks.keys) == 0 && len(ks.byKid) == 0
+}
+
+// candidates gets the keys that may verify a token, only keys of the type its
+// algorithm requires so that a public key is never used as an HMAC secret.
+func (ks *KeySet) candidates(token *jwt.Token) []interface{} {
+	var keys []interface{}
+	if kid, ok := token.Header["kid"].(string); ok && kid != "" {
+		if key, ok := ks.byKid[kid]; ok {
+			keys = []interface{}{key}
+		}
+	} else {
+		for _, secret := range ks.hmac {
+			keys = append(keys, secret)
+		}
+		keys = append(keys, ks.keys...)
+	}
+
+	var res []interface{}
+	for _, key := range keys {
+		switch key.(type) {
+		case []byte:
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+				res = append(res, key)
+			}
+		case *rsa.PublicKey:
+			switch token.Method.(type) {
+			case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+				res = append(res, key)
+			}
+		case *ecdsa.PublicKey:
+			if _, ok := token.Method.(*jwt.SigningMethodECDSA); ok {
+				res = append(res, key)
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,66 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/server"
+)
+
+// DefaultTenantClaim is the token claim naming the tenant of the caller.
+const DefaultTenantClaim = "tenant"
+
+type tenantKey struct{}
+
+// NewTenantContext returns a copy of ctx carrying the tenant of the caller.
+func NewTenantContext(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext gets the tenant of the caller of the RPC of ctx, empty when the
+// service is not multi-tenant.
+func TenantFromContext(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantKey{}).(string)
+	return tenant
+}
+
+// Tenancy is a handler wrapper putting the tenant of the caller in the context of the call.
+// The tenant is the claim of the authenticated principal or, for callers without a token
+// and if key is not empty, the metadata key. A token without the claim does not fall back
+// to the metadata, which its bearer could set to any tenant. Calls without a tenant fail
+// with an Unauthorized error.
+func Tenancy(claim string, key string) server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			var tenant string
+			if p, ok := FromContext(ctx); ok {
+				tenant, _ = p.Claims[claim].(string)
+				if tenant == "" {
+					return errors.Unauthorized(req.Service(), "no %s claim in the token", claim)
+				}
+			} else if key != "" {
+				tenant, _ = metadataValue(ctx, key)
+			}
+
+			if tenant == "" {
+				return errors.Unauthorized(req.Service(), "no tenant for the caller")
+			}
+
+			return fn(NewTenantContext(ctx, tenant), req, rsp)
+		}
+	}
+}
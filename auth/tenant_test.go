@@ -0,0 +1,74 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/micro/go-micro/metadata"
+	"github.com/micro/go-micro/server"
+)
+
+// serverRequest names the embedded interface apart from its Request method.
+type serverRequest = server.Request
+
+type request struct {
+	serverRequest
+}
+
+func (r request) Service() string {
+	return "test"
+}
+
+func TestTenancy(t *testing.T) {
+	token := &Principal{Subject: "alice", Claims: map[string]interface{}{"tenant": "acme"}}
+	noClaim := &Principal{Subject: "alice", Claims: map[string]interface{}{}}
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		md        metadata.Metadata
+		key       string
+		want      string
+	}{
+		{"claim", token, nil, "", "acme"},
+		{"claim over metadata", token, metadata.Metadata{"X-Casbin-Tenant": "other"}, "X-Casbin-Tenant", "acme"},
+		{"token without claim", noClaim, metadata.Metadata{"X-Casbin-Tenant": "other"}, "X-Casbin-Tenant", ""},
+		{"metadata", nil, metadata.Metadata{"X-Casbin-Tenant": "acme"}, "X-Casbin-Tenant", "acme"},
+		{"metadata not trusted", nil, metadata.Metadata{"X-Casbin-Tenant": "acme"}, "", ""},
+	}
+
+	for _, tt := range tests {
+		ctx := metadata.NewContext(context.Background(), tt.md)
+		if tt.principal != nil {
+			ctx = NewContext(ctx, tt.principal)
+		}
+
+		var got string
+		err := Tenancy("tenant", tt.key)(func(ctx context.Context, req server.Request, rsp interface{}) error {
+			got = TenantFromContext(ctx)
+			return nil
+		})(ctx, request{}, nil)
+
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("%s: got tenant %q, want an error", tt.name, got)
+			}
+		} else if err != nil || got != tt.want {
+			t.Errorf("%s: got %q %v, want %q", tt.name, got, err, tt.want)
+		}
+	}
+}
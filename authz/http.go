@@ -0,0 +1,156 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cicdi-go/casbin/auth"
+	"github.com/micro/go-log"
+)
+
+// HTTPMapper maps an HTTP request to the request of the enforcer. An empty sub means
+// the caller is unknown.
+type HTTPMapper func(r *http.Request) (sub string, obj string, act string)
+
+// HTTPOptions configures the HTTP middleware.
+type HTTPOptions struct {
+	// Mapper maps the requests, DefaultHTTPMapper(PrincipalHeader) by default.
+	Mapper HTTPMapper
+	// PrincipalHeader is the header naming the callers without a token. It is empty by
+	// default, only the callers with a token are known, since any client can set headers.
+	PrincipalHeader string
+	// Keys verifies the bearer tokens of the Authorization header, whose subject is then
+	// the caller. With keys every request needs a token, without keys the tokens are ignored.
+	Keys *auth.KeySet
+	// JWT are the options of the token verification.
+	JWT []auth.JWTOption
+	// FailOpen lets the requests through when the decider fails, otherwise they are rejected.
+	FailOpen bool
+}
+
+// HTTPOption sets an option of the HTTP middleware.
+type HTTPOption func(*HTTPOptions)
+
+// WithHTTPMapper sets the mapping of the HTTP requests.
+func WithHTTPMapper(m HTTPMapper) HTTPOption {
+	return func(o *HTTPOptions) {
+		o.Mapper = m
+	}
+}
+
+// Tokens authenticates the callers with bearer tokens signed by a key of keys, the
+// requests without a token are rejected rather than mapped from their headers.
+func Tokens(keys *auth.KeySet, opts ...auth.JWTOption) HTTPOption {
+	return func(o *HTTPOptions) {
+		o.Keys = keys
+		o.JWT = opts
+	}
+}
+
+// HTTPTrustPrincipalHeader names the callers without a token by the header, e.g.
+// auth.DefaultPrincipalKey. Only use it behind a proxy that sets the header itself.
+func HTTPTrustPrincipalHeader(header string) HTTPOption {
+	return func(o *HTTPOptions) {
+		o.PrincipalHeader = header
+	}
+}
+
+// HTTPFailOpen sets whether the requests are let through when the decider fails.
+func HTTPFailOpen(b bool) HTTPOption {
+	return func(o *HTTPOptions) {
+		o.FailOpen = b
+	}
+}
+
+// DefaultHTTPMapper maps a request to the caller, as authenticated by a token or, unless
+// header is empty, named by the header, the URL path as obj and the HTTP method as act,
+// which suits the RESTful models matching paths with keyMatch2 and methods with regexMatch.
+func DefaultHTTPMapper(header string) HTTPMapper {
+	return func(r *http.Request) (string, string, string) {
+		if p, ok := auth.FromContext(r.Context()); ok {
+			return p.Subject, r.URL.Path, r.Method
+		}
+		if header == "" {
+			return "", r.URL.Path, r.Method
+		}
+		return r.Header.Get(header), r.URL.Path, r.Method
+	}
+}
+
+// HTTP is a middleware enforcing every request with d. Denied requests get a 403 status,
+// requests from unknown callers or with a missing or invalid token a 401 status and, when
+// d fails and the middleware fails closed, a 503 status.
+func HTTP(d Decider, opts ...HTTPOption) func(http.Handler) http.Handler {
+	options := HTTPOptions{}
+	for _, o := range opts {
+		o(&options)
+	}
+	if options.Mapper == nil {
+		options.Mapper = DefaultHTTPMapper(options.PrincipalHeader)
+	}
+	if options.PrincipalHeader != "" {
+		log.Logf("warning: the %s header names the callers without a token, any client can set it", options.PrincipalHeader)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if options.Keys != nil {
+				raw, ok := bearerToken(r)
+				if !ok {
+					http.Error(w, "missing bearer token", http.StatusUnauthorized)
+					return
+				}
+
+				p, err := auth.Verify(options.Keys, raw, options.JWT...)
+				if err != nil {
+					http.Error(w, "invalid token: "+err.Error(), http.StatusUnauthorized)
+					return
+				}
+				r = r.WithContext(auth.NewContext(r.Context(), p))
+			}
+
+			sub, obj, act := options.Mapper(r)
+			if sub == "" {
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			allowed, err := d.Enforce(r.Context(), sub, obj, act)
+			if err != nil {
+				log.Logf("authorizing %s %s: %v", act, obj, err)
+				if !options.FailOpen {
+					http.Error(w, "authorization unavailable", http.StatusServiceUnavailable)
+					return
+				}
+			} else if !allowed {
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	value := r.Header.Get("Authorization")
+	if len(value) < 7 || !strings.EqualFold(value[:7], "Bearer ") {
+		return "", false
+	}
+
+	return strings.TrimSpace(value[7:]), true
+}
@@ -0,0 +1,139 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/casbin/casbin"
+	"github.com/cicdi-go/casbin/auth"
+	"github.com/cicdi-go/casbin/authz"
+	"github.com/dgrijalva/jwt-go"
+)
+
+var ok = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+})
+
+func restfulEnforcer(t *testing.T) *casbin.Enforcer {
+	e, err := casbin.NewEnforcerSafe("../models/restful_model.conf", "../models/restful_policy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func serve(h http.Handler, method string, path string, header string, value string) int {
+	r := httptest.NewRequest(method, path, nil)
+	if header != "" {
+		r.Header.Set(header, value)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestHTTPRestfulModel(t *testing.T) {
+	h := authz.HTTP(authz.Local(restfulEnforcer(t)), authz.HTTPTrustPrincipalHeader(auth.DefaultPrincipalKey))(ok)
+
+	tests := []struct {
+		sub    string
+		method string
+		path   string
+		code   int
+	}{
+		// keyMatch2 with a trailing wildcard.
+		{"alice", "GET", "/alice_data/resource1", http.StatusOK},
+		{"alice", "GET", "/alice_data/any/thing", http.StatusOK},
+		{"alice", "POST", "/alice_data/resource1", http.StatusOK},
+		{"alice", "POST", "/alice_data/resource2", http.StatusForbidden},
+		{"alice", "GET", "/bob_data/resource1", http.StatusForbidden},
+		{"bob", "GET", "/alice_data/resource2", http.StatusOK},
+		{"bob", "POST", "/bob_data/resource1", http.StatusOK},
+		{"bob", "GET", "/bob_data/resource1", http.StatusForbidden},
+
+		// keyMatch2 path params, granted through roles.
+		{"cathy", "GET", "/users/42", http.StatusOK},
+		{"cathy", "GET", "/users/42/keys", http.StatusForbidden},
+		{"cathy", "PUT", "/users/42", http.StatusForbidden},
+		{"cathy", "GET", "/users", http.StatusForbidden},
+
+		// regexMatch alternatives of the HTTP method.
+		{"dave", "GET", "/users/42", http.StatusOK},
+		{"dave", "PUT", "/users/42", http.StatusOK},
+		{"dave", "DELETE", "/users/42", http.StatusOK},
+		{"dave", "PATCH", "/users/42", http.StatusForbidden},
+		{"dave", "POST", "/users", http.StatusOK},
+		{"dave", "DELETE", "/users", http.StatusForbidden},
+
+		{"eve", "GET", "/users/42", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		if code := serve(h, tt.method, tt.path, auth.DefaultPrincipalKey, tt.sub); code != tt.code {
+			t.Errorf("%s %s %s: got %d, want %d", tt.sub, tt.method, tt.path, code, tt.code)
+		}
+	}
+}
+
+func TestHTTPUnknownCaller(t *testing.T) {
+	h := authz.HTTP(authz.Local(restfulEnforcer(t)))(ok)
+
+	if code := serve(h, "GET", "/alice_data/resource1", "", ""); code != http.StatusUnauthorized {
+		t.Errorf("got %d, want %d", code, http.StatusUnauthorized)
+	}
+	// The header is not trusted by default.
+	if code := serve(h, "GET", "/alice_data/resource1", auth.DefaultPrincipalKey, "alice"); code != http.StatusUnauthorized {
+		t.Errorf("principal header: got %d, want %d", code, http.StatusUnauthorized)
+	}
+}
+
+func TestHTTPTokens(t *testing.T) {
+	secret := []byte("test secret")
+	keys := auth.NewKeySet()
+	keys.AddHMAC(secret)
+
+	h := authz.HTTP(authz.Local(restfulEnforcer(t)), authz.Tokens(keys))(ok)
+
+	sign := func(sub string) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": sub}).SignedString(secret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return "Bearer " + token
+	}
+
+	tests := []struct {
+		name   string
+		header string
+		value  string
+		code   int
+	}{
+		{"token", "Authorization", sign("alice"), http.StatusOK},
+		{"denied token", "Authorization", sign("bob"), http.StatusForbidden},
+		{"invalid token", "Authorization", "Bearer not.a.token", http.StatusUnauthorized},
+		{"principal header", auth.DefaultPrincipalKey, "alice", http.StatusUnauthorized},
+		{"no credentials", "", "", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		if code := serve(h, "GET", "/alice_data/resource1", tt.header, tt.value); code != tt.code {
+			t.Errorf("%s: got %d, want %d", tt.name, code, tt.code)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package authz protects the RPCs of go-micro services and plain HTTP handlers: every
+// incoming request is mapped to a (sub, obj, act) request and enforced by the casbin
+// service or an embedded enforcer.
+package authz
+
+import (
+	"context"
+	"strings"
+
+	"github.com/casbin/casbin"
+	"github.com/cicdi-go/casbin/auth"
+	"github.com/micro/go-log"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/server"
+)
+
+// StatusServiceUnavailable is the error code of the calls rejected because the decider
+// failed, when failing closed.
+const StatusServiceUnavailable = 503
+
+// Decider decides the requests, e.g. a *client.Enforcer calling the casbin service.
+type Decider interface {
+	Enforce(ctx context.Context, params ...interface{}) (bool, error)
+}
+
+type local struct {
+	e *casbin.Enforcer
+}
+
+// Local decides the requests with an embedded enforcer.
+func Local(e *casbin.Enforcer) Decider {
+	return local{e: e}
+}
+
+func (l local) Enforce(ctx context.Context, params ...interface{}) (bool, error) {
+	return l.e.EnforceSafe(params...)
+}
+
+// Mapper maps an incoming request to the request of the enforcer. An empty sub means
+// the caller is unknown.
+type Mapper func(ctx context.Context, req server.Request) (sub string, obj string, act string)
+
+// Options configures the authorization wrapper.
+type Options struct {
+	// Mapper maps the requests, DefaultMapper(PrincipalKey) by default.
+	Mapper Mapper
+	// PrincipalKey is the metadata key naming the callers without an authenticated
+	// principal. It is empty by default, only the callers authenticated by auth.JWT are
+	// known, since any caller can set metadata.
+	PrincipalKey string
+	// FailOpen lets the calls through when the decider fails, e.g. when the casbin
+	// service cannot be reached, otherwise they are rejected.
+	FailOpen bool
+	// Public lists the endpoints, e.g. "Health.Check", that are not enforced.
+	Public map[string]bool
+}
+
+// Option sets an option of the authorization wrapper.
+type Option func(*Options)
+
+// WithMapper sets the mapping of the requests.
+func WithMapper(m Mapper) Option {
+	return func(o *Options) {
+		o.Mapper = m
+	}
+}
+
+// TrustPrincipalHeader names the callers without a token by the metadata key, e.g.
+// auth.DefaultPrincipalKey. Only use it behind a gateway that sets the metadata itself.
+func TrustPrincipalHeader(key string) Option {
+	return func(o *Options) {
+		o.PrincipalKey = key
+	}
+}
+
+// FailOpen sets whether the calls are let through when the decider fails.
+func FailOpen(b bool) Option {
+	return func(o *Options) {
+		o.FailOpen = b
+	}
+}
+
+// Public adds endpoints that anyone can call.
+func Public(endpoints ...string) Option {
+	return func(o *Options) {
+		if o.Public == nil {
+			o.Public = map[string]bool{}
+		}
+		for _, endpoint := range endpoints {
+			o.Public[endpoint] = true
+		}
+	}
+}
+
+// DefaultMapper maps a request to the caller, as authenticated or named by the metadata
+// key, the service and handler as obj, e.g. "go.micro.srv.greeter/Greeter", and the
+// method as act, e.g. "Hello".
+func DefaultMapper(key string) Mapper {
+	return func(ctx context.Context, req server.Request) (string, string, string) {
+		handler, method := splitEndpoint(req.Method())
+		return auth.Subject(ctx, key), req.Service() + "/" + handler, method
+	}
+}
+
+func splitEndpoint(endpoint string) (string, string) {
+	if i := strings.LastIndex(endpoint, "."); i != -1 {
+		return endpoint[:i], endpoint[i+1:]
+	}
+	return "", endpoint
+}
+
+// Wrapper is a handler wrapper enforcing every incoming request with d. Denied calls fail
+// with a Forbidden error, calls from unknown callers with an Unauthorized error.
+func Wrapper(d Decider, opts ...Option) server.HandlerWrapper {
+	options := Options{}
+	for _, o := range opts {
+		o(&options)
+	}
+	if options.Mapper == nil {
+		options.Mapper = DefaultMapper(options.PrincipalKey)
+	}
+	if options.PrincipalKey != "" {
+		log.Logf("warning: the %s metadata names the callers without a token, any caller can set it", options.PrincipalKey)
+	}
+
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			if options.Public[req.Method()] {
+				return fn(ctx, req, rsp)
+			}
+
+			sub, obj, act := options.Mapper(ctx, req)
+			if sub == "" {
+				return errors.Unauthorized(req.Service(), "no principal for %s", req.Method())
+			}
+
+			allowed, err := d.Enforce(ctx, sub, obj, act)
+			if err != nil {
+				log.Logf("authorizing %s on %s: %v", act, obj, err)
+				if !options.FailOpen {
+					return errors.New(req.Service(), "authorization unavailable", StatusServiceUnavailable)
+				}
+				return fn(ctx, req, rsp)
+			}
+			if !allowed {
+				return errors.Forbidden(req.Service(), "%s may not %s %s", sub, act, obj)
+			}
+
+			return fn(ctx, req, rsp)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cicdi-go/casbin/auth"
+	"github.com/cicdi-go/casbin/authz"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/metadata"
+	"github.com/micro/go-micro/server"
+)
+
+// serverRequest names the embedded interface apart from its Request method.
+type serverRequest = server.Request
+
+type request struct {
+	serverRequest
+}
+
+func (r request) Service() string {
+	return "go.micro.srv.greeter"
+}
+
+func (r request) Method() string {
+	return "Greeter.Hello"
+}
+
+// alice allows alice only.
+type alice struct{}
+
+func (alice) Enforce(ctx context.Context, params ...interface{}) (bool, error) {
+	return params[0] == "alice", nil
+}
+
+func call(ctx context.Context, w server.HandlerWrapper) int32 {
+	err := w(func(ctx context.Context, req server.Request, rsp interface{}) error {
+		return nil
+	})(ctx, request{}, nil)
+	if err == nil {
+		return 200
+	}
+	return errors.Parse(err.Error()).Code
+}
+
+func TestWrapperPrincipalHeader(t *testing.T) {
+	header := metadata.NewContext(context.Background(), metadata.Metadata{auth.DefaultPrincipalKey: "alice"})
+	token := auth.NewContext(context.Background(), &auth.Principal{Subject: "alice"})
+
+	tests := []struct {
+		name string
+		w    server.HandlerWrapper
+		ctx  context.Context
+		code int32
+	}{
+		{"token", authz.Wrapper(alice{}), token, 200},
+		{"untrusted header", authz.Wrapper(alice{}), header, 401},
+		{"trusted header", authz.Wrapper(alice{}, authz.TrustPrincipalHeader(auth.DefaultPrincipalKey)), header, 200},
+	}
+
+	for _, tt := range tests {
+		if code := call(tt.ctx, tt.w); code != tt.code {
+			t.Errorf("%s: got %d, want %d", tt.name, code, tt.code)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+type decision struct {
+	allowed bool
+	expires time.Time
+}
+
+// cache keeps the decisions of Enforce per enforcer handle. Every invalidation of a
+// handle bumps its generation, so that a decision fetched before is not stored after.
+type cache struct {
+	size int
+	ttl  time.Duration
+
+	mu          sync.Mutex
+	count       int
+	decisions   map[int32]map[string]decision
+	generations map[int32]uint64
+}
+
+func newCache(size int, ttl time.Duration) *cache {
+	return &cache{
+		size:        size,
+		ttl:         ttl,
+		decisions:   map[int32]map[string]decision{},
+		generations: map[int32]uint64{},
+	}
+}
+
+// get looks up a decision, returning the generation of the handle to pass to put on a miss.
+func (c *cache) get(h int32, params []string) (bool, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := strings.Join(params, "\x00")
+	d, ok := c.decisions[h][key]
+	if ok && c.ttl > 0 && time.Now().After(d.expires) {
+		delete(c.decisions[h], key)
+		c.count--
+		ok = false
+	}
+
+	return d.allowed, c.generations[h], ok
+}
+
+func (c *cache) put(h int32, params []string, allowed bool, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[h] != gen {
+		return
+	}
+
+	key := strings.Join(params, "\x00")
+	if _, ok := c.decisions[h][key]; !ok {
+		if c.count >= c.size {
+			c.evict()
+		}
+		c.count++
+	}
+	if c.decisions[h] == nil {
+		c.decisions[h] = map[string]decision{}
+	}
+	c.decisions[h][key] = decision{allowed: allowed, expires: time.Now().Add(c.ttl)}
+}
+
+// evict drops an arbitrary decision.
+func (c *cache) evict() {
+	for h, decisions := range c.decisions {
+		for key := range decisions {
+			delete(decisions, key)
+			c.count--
+			return
+		}
+		delete(c.decisions, h)
+	}
+}
+
+func (c *cache) invalidate(h int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.count -= len(c.decisions[h])
+	delete(c.decisions, h)
+	c.generations[h]++
+}
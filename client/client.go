@@ -0,0 +1,252 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client is the Go SDK of the casbin service. It keeps the enforcer handles by
+// name, turns the replies into plain Go values, retries the reads and can cache decisions.
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+	"github.com/micro/go-micro"
+	microclient "github.com/micro/go-micro/client"
+	microerrors "github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/server"
+)
+
+// DefaultServiceName is the registry name of the casbin service.
+const DefaultServiceName = "go.micro.srv.casbin"
+
+// DefaultPolicyTopic is the topic the service publishes the policy change events to.
+const DefaultPolicyTopic = "go.micro.srv.casbin.policy"
+
+// ErrUnknownEnforcer is returned by the calls on an enforcer name that was never bound to a handle.
+var ErrUnknownEnforcer = errors.New("client: unknown enforcer")
+
+// Options configures a Client.
+type Options struct {
+	// Retries is the number of times a read RPC is retried after a transient failure.
+	// Writes are never retried.
+	Retries int
+	// Timeout bounds every RPC, 0 for the default of the go-micro client.
+	Timeout time.Duration
+	// CacheSize is the number of decisions of Enforce kept locally, 0 to not cache.
+	CacheSize int
+	// CacheTTL is how long a cached decision is used, 0 to keep it until the policy of
+	// its enforcer changes.
+	CacheTTL time.Duration
+	// Tenant is the tenant of the client, only its policy change events invalidate the
+	// cache. Empty means the events of every tenant do.
+	Tenant string
+}
+
+// Option sets an option of a Client.
+type Option func(*Options)
+
+// Retries sets the number of retries of the read RPCs.
+func Retries(n int) Option {
+	return func(o *Options) {
+		o.Retries = n
+	}
+}
+
+// Timeout sets the timeout of every RPC.
+func Timeout(d time.Duration) Option {
+	return func(o *Options) {
+		o.Timeout = d
+	}
+}
+
+// Cache enables the local cache of size decisions, each used for at most ttl.
+func Cache(size int, ttl time.Duration) Option {
+	return func(o *Options) {
+		o.CacheSize = size
+		o.CacheTTL = ttl
+	}
+}
+
+// Tenant sets the tenant whose policy change events invalidate the cache.
+func Tenant(name string) Option {
+	return func(o *Options) {
+		o.Tenant = name
+	}
+}
+
+// Client calls the casbin service.
+type Client struct {
+	opts  Options
+	svc   pb.CasbinService
+	cache *cache
+
+	mu        sync.RWMutex
+	enforcers map[string]int32
+}
+
+// New creates a client of the service registered as name, DefaultServiceName if empty,
+// calling it through c, the default go-micro client if nil.
+func New(name string, c microclient.Client, opts ...Option) *Client {
+	options := Options{Retries: 1}
+	for _, o := range opts {
+		o(&options)
+	}
+	if name == "" {
+		name = DefaultServiceName
+	}
+
+	cl := &Client{
+		opts:      options,
+		svc:       pb.NewCasbinService(name, c),
+		enforcers: map[string]int32{},
+	}
+	if options.CacheSize > 0 {
+		cl.cache = newCache(options.CacheSize, options.CacheTTL)
+	}
+
+	return cl
+}
+
+// Service gets the generated client, for the RPCs the SDK does not wrap.
+func (c *Client) Service() pb.CasbinService {
+	return c.svc
+}
+
+// Bind names the enforcer handle, e.g. one created by another process.
+func (c *Client) Bind(name string, handle int32) {
+	c.mu.Lock()
+	c.enforcers[name] = handle
+	c.mu.Unlock()
+}
+
+// Enforcer gets the enforcer bound to name. Its calls fail with ErrUnknownEnforcer
+// while no handle is bound to the name.
+func (c *Client) Enforcer(name string) *Enforcer {
+	return &Enforcer{c: c, name: name}
+}
+
+func (c *Client) handle(name string) (int32, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	h, ok := c.enforcers[name]
+	if !ok {
+		return 0, ErrUnknownEnforcer
+	}
+	return h, nil
+}
+
+// EnforcerConfig describes the enforcer created by NewEnforcer.
+type EnforcerConfig struct {
+	// ModelText is the model, unless ModelTemplate names a built-in one.
+	ModelText     string
+	ModelTemplate string
+	// AdapterHandle is the adapter holding the policy, -1 for none.
+	AdapterHandle int32
+}
+
+// NewEnforcer creates an enforcer and binds its handle to name. If the enforcer was created
+// but its policy failed to load, the enforcer is returned with a *LoadError.
+func (c *Client) NewEnforcer(ctx context.Context, name string, cfg EnforcerConfig) (*Enforcer, error) {
+	out, err := c.svc.NewEnforcer(ctx, &pb.NewEnforcerRequest{
+		ModelText:     cfg.ModelText,
+		ModelTemplate: cfg.ModelTemplate,
+		AdapterHandle: cfg.AdapterHandle,
+	}, c.writeOptions()...)
+	if err != nil {
+		return nil, wrapError("NewEnforcer", err)
+	}
+
+	c.Bind(name, out.Handler)
+	if out.LoadError != "" {
+		return c.Enforcer(name), &LoadError{Detail: out.LoadError}
+	}
+	return c.Enforcer(name), nil
+}
+
+// AdapterConfig describes the adapter created by NewAdapter.
+type AdapterConfig struct {
+	AdapterName   string
+	DriverName    string
+	ConnectString string
+	Params        map[string]string
+}
+
+// NewAdapter creates an adapter and returns its handle.
+func (c *Client) NewAdapter(ctx context.Context, cfg AdapterConfig) (int32, error) {
+	out, err := c.svc.NewAdapter(ctx, &pb.NewAdapterRequest{
+		AdapterName:   cfg.AdapterName,
+		DriverName:    cfg.DriverName,
+		ConnectString: cfg.ConnectString,
+		Params:        cfg.Params,
+	}, c.writeOptions()...)
+	if err != nil {
+		return 0, wrapError("NewAdapter", err)
+	}
+
+	return out.Handler, nil
+}
+
+// Subscribe registers the handler of the policy change events published to topic,
+// DefaultPolicyTopic if empty, on the server s, e.g. service.Server().
+func (c *Client) Subscribe(s server.Server, topic string) error {
+	if topic == "" {
+		topic = DefaultPolicyTopic
+	}
+	return micro.RegisterSubscriber(topic, s, c.HandleEvent)
+}
+
+// HandleEvent drops the cached decisions of the enforcer whose policy changed.
+func (c *Client) HandleEvent(ctx context.Context, ev *pb.PolicyChangeEvent) error {
+	if c.cache != nil && (c.opts.Tenant == "" || c.opts.Tenant == ev.Tenant) {
+		c.cache.invalidate(ev.EnforcerHandler)
+	}
+	return nil
+}
+
+func (c *Client) readOptions() []microclient.CallOption {
+	opts := []microclient.CallOption{microclient.WithRetries(c.opts.Retries), microclient.WithRetry(retryTransient)}
+	if c.opts.Timeout > 0 {
+		opts = append(opts, microclient.WithRequestTimeout(c.opts.Timeout))
+	}
+	return opts
+}
+
+func (c *Client) writeOptions() []microclient.CallOption {
+	opts := []microclient.CallOption{microclient.WithRetries(0)}
+	if c.opts.Timeout > 0 {
+		opts = append(opts, microclient.WithRequestTimeout(c.opts.Timeout))
+	}
+	return opts
+}
+
+// retryTransient retries the calls that failed before reaching the handler: timeouts,
+// unavailable services and transport errors.
+func retryTransient(ctx context.Context, req microclient.Request, retryCount int, err error) (bool, error) {
+	if err == nil {
+		return false, nil
+	}
+
+	e, ok := err.(*microerrors.Error)
+	if !ok {
+		return true, nil
+	}
+	switch e.Code {
+	case 408, 502, 503, 504:
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,316 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+// Enforcer calls the service on the enforcer bound to a name.
+type Enforcer struct {
+	c    *Client
+	name string
+}
+
+// Handle gets the handle bound to the name of the enforcer.
+func (e *Enforcer) Handle() (int32, error) {
+	return e.c.handle(e.name)
+}
+
+// Enforce decides whether the request params is allowed. Strings are passed as they are,
+// any other value is an ABAC object marshalled like MakeABAC does.
+func (e *Enforcer) Enforce(ctx context.Context, params ...interface{}) (bool, error) {
+	h, err := e.Handle()
+	if err != nil {
+		return false, err
+	}
+
+	in := &pb.EnforceRequest{EnforcerHandler: h, Params: make([]string, len(params))}
+	for i, p := range params {
+		if in.Params[i], err = makeParam(p); err != nil {
+			return false, err
+		}
+	}
+
+	var gen uint64
+	if e.c.cache != nil {
+		var allowed, ok bool
+		if allowed, gen, ok = e.c.cache.get(h, in.Params); ok {
+			return allowed, nil
+		}
+	}
+
+	out, err := e.c.svc.Enforce(ctx, in, e.c.readOptions()...)
+	if err != nil {
+		return false, wrapError("Enforce", err)
+	}
+
+	if e.c.cache != nil {
+		e.c.cache.put(h, in.Params, out.Res, gen)
+	}
+	return out.Res, nil
+}
+
+func makeParam(p interface{}) (string, error) {
+	if s, ok := p.(string); ok {
+		return s, nil
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return "ABAC::" + string(data), nil
+}
+
+// LoadPolicy reloads the policy from the adapter of the enforcer.
+func (e *Enforcer) LoadPolicy(ctx context.Context) error {
+	h, err := e.Handle()
+	if err != nil {
+		return err
+	}
+
+	_, err = e.c.svc.LoadPolicy(ctx, &pb.EmptyRequest{Handler: h}, e.c.writeOptions()...)
+	return e.changed(h, "LoadPolicy", err)
+}
+
+// SavePolicy saves the policy to the adapter of the enforcer.
+func (e *Enforcer) SavePolicy(ctx context.Context) error {
+	h, err := e.Handle()
+	if err != nil {
+		return err
+	}
+
+	_, err = e.c.svc.SavePolicy(ctx, &pb.EmptyRequest{Handler: h}, e.c.writeOptions()...)
+	return wrapError("SavePolicy", err)
+}
+
+// GetPolicy gets all the policy rules.
+func (e *Enforcer) GetPolicy(ctx context.Context) ([][]string, error) {
+	h, err := e.Handle()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := e.c.svc.GetPolicy(ctx, &pb.ListRequest{Handler: h}, e.c.readOptions()...)
+	if err != nil {
+		return nil, wrapError("GetPolicy", err)
+	}
+	return rules(out), nil
+}
+
+// GetFilteredPolicy gets the policy rules whose fields from fieldIndex on match fieldValues,
+// an empty value matching anything.
+func (e *Enforcer) GetFilteredPolicy(ctx context.Context, fieldIndex int, fieldValues ...string) ([][]string, error) {
+	h, err := e.Handle()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := e.c.svc.GetFilteredPolicy(ctx, &pb.FilteredPolicyRequest{
+		EnforcerHandler: h,
+		FieldIndex:      int32(fieldIndex),
+		FieldValues:     fieldValues,
+	}, e.c.readOptions()...)
+	if err != nil {
+		return nil, wrapError("GetFilteredPolicy", err)
+	}
+	return rules(out), nil
+}
+
+// GetGroupingPolicy gets all the role inheritance rules.
+func (e *Enforcer) GetGroupingPolicy(ctx context.Context) ([][]string, error) {
+	h, err := e.Handle()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := e.c.svc.GetGroupingPolicy(ctx, &pb.ListRequest{Handler: h}, e.c.readOptions()...)
+	if err != nil {
+		return nil, wrapError("GetGroupingPolicy", err)
+	}
+	return rules(out), nil
+}
+
+// HasPolicy determines whether the policy rule exists.
+func (e *Enforcer) HasPolicy(ctx context.Context, rule ...string) (bool, error) {
+	h, err := e.Handle()
+	if err != nil {
+		return false, err
+	}
+
+	out, err := e.c.svc.HasPolicy(ctx, &pb.PolicyRequest{EnforcerHandler: h, Params: rule}, e.c.readOptions()...)
+	if err != nil {
+		return false, wrapError("HasPolicy", err)
+	}
+	return out.Res, nil
+}
+
+// AddPolicy adds the policy rule, false if it already exists.
+func (e *Enforcer) AddPolicy(ctx context.Context, rule ...string) (bool, error) {
+	return e.write(ctx, "AddPolicy", func(h int32) (*pb.BoolReply, error) {
+		return e.c.svc.AddPolicy(ctx, &pb.PolicyRequest{EnforcerHandler: h, Params: rule}, e.c.writeOptions()...)
+	})
+}
+
+// RemovePolicy removes the policy rule, false if it does not exist.
+func (e *Enforcer) RemovePolicy(ctx context.Context, rule ...string) (bool, error) {
+	return e.write(ctx, "RemovePolicy", func(h int32) (*pb.BoolReply, error) {
+		return e.c.svc.RemovePolicy(ctx, &pb.PolicyRequest{EnforcerHandler: h, Params: rule}, e.c.writeOptions()...)
+	})
+}
+
+// RemoveFilteredPolicy removes the policy rules whose fields from fieldIndex on match fieldValues.
+func (e *Enforcer) RemoveFilteredPolicy(ctx context.Context, fieldIndex int, fieldValues ...string) (bool, error) {
+	return e.write(ctx, "RemoveFilteredPolicy", func(h int32) (*pb.BoolReply, error) {
+		return e.c.svc.RemoveFilteredPolicy(ctx, &pb.FilteredPolicyRequest{
+			EnforcerHandler: h,
+			FieldIndex:      int32(fieldIndex),
+			FieldValues:     fieldValues,
+		}, e.c.writeOptions()...)
+	})
+}
+
+// AddGroupingPolicy adds the role inheritance rule, false if it already exists.
+func (e *Enforcer) AddGroupingPolicy(ctx context.Context, rule ...string) (bool, error) {
+	return e.write(ctx, "AddGroupingPolicy", func(h int32) (*pb.BoolReply, error) {
+		return e.c.svc.AddGroupingPolicy(ctx, &pb.PolicyRequest{EnforcerHandler: h, Params: rule}, e.c.writeOptions()...)
+	})
+}
+
+// RemoveGroupingPolicy removes the role inheritance rule, false if it does not exist.
+func (e *Enforcer) RemoveGroupingPolicy(ctx context.Context, rule ...string) (bool, error) {
+	return e.write(ctx, "RemoveGroupingPolicy", func(h int32) (*pb.BoolReply, error) {
+		return e.c.svc.RemoveGroupingPolicy(ctx, &pb.PolicyRequest{EnforcerHandler: h, Params: rule}, e.c.writeOptions()...)
+	})
+}
+
+// GetRolesForUser gets the roles of the user.
+func (e *Enforcer) GetRolesForUser(ctx context.Context, user string) ([]string, error) {
+	h, err := e.Handle()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := e.c.svc.GetRolesForUser(ctx, &pb.UserRoleRequest{EnforcerHandler: h, User: user}, e.c.readOptions()...)
+	if err != nil {
+		return nil, wrapError("GetRolesForUser", err)
+	}
+	return out.Array, nil
+}
+
+// GetUsersForRole gets the users having the role.
+func (e *Enforcer) GetUsersForRole(ctx context.Context, role string) ([]string, error) {
+	h, err := e.Handle()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := e.c.svc.GetUsersForRole(ctx, &pb.UserRoleRequest{EnforcerHandler: h, Role: role}, e.c.readOptions()...)
+	if err != nil {
+		return nil, wrapError("GetUsersForRole", err)
+	}
+	return out.Array, nil
+}
+
+// HasRoleForUser determines whether the user has the role.
+func (e *Enforcer) HasRoleForUser(ctx context.Context, user string, role string) (bool, error) {
+	h, err := e.Handle()
+	if err != nil {
+		return false, err
+	}
+
+	out, err := e.c.svc.HasRoleForUser(ctx, &pb.UserRoleRequest{EnforcerHandler: h, User: user, Role: role}, e.c.readOptions()...)
+	if err != nil {
+		return false, wrapError("HasRoleForUser", err)
+	}
+	return out.Res, nil
+}
+
+// AddRoleForUser gives the role to the user, false if the user already has it.
+func (e *Enforcer) AddRoleForUser(ctx context.Context, user string, role string) (bool, error) {
+	return e.write(ctx, "AddRoleForUser", func(h int32) (*pb.BoolReply, error) {
+		return e.c.svc.AddRoleForUser(ctx, &pb.UserRoleRequest{EnforcerHandler: h, User: user, Role: role}, e.c.writeOptions()...)
+	})
+}
+
+// DeleteRoleForUser takes the role from the user, false if the user does not have it.
+func (e *Enforcer) DeleteRoleForUser(ctx context.Context, user string, role string) (bool, error) {
+	return e.write(ctx, "DeleteRoleForUser", func(h int32) (*pb.BoolReply, error) {
+		return e.c.svc.DeleteRoleForUser(ctx, &pb.UserRoleRequest{EnforcerHandler: h, User: user, Role: role}, e.c.writeOptions()...)
+	})
+}
+
+// GetPermissionsForUser gets the permissions given to the user directly.
+func (e *Enforcer) GetPermissionsForUser(ctx context.Context, user string) ([][]string, error) {
+	h, err := e.Handle()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := e.c.svc.GetPermissionsForUser(ctx, &pb.PermissionRequest{EnforcerHandler: h, User: user}, e.c.readOptions()...)
+	if err != nil {
+		return nil, wrapError("GetPermissionsForUser", err)
+	}
+	return rules(out), nil
+}
+
+// AddPermissionForUser gives the permission to the user, false if the user already has it.
+func (e *Enforcer) AddPermissionForUser(ctx context.Context, user string, permission ...string) (bool, error) {
+	return e.write(ctx, "AddPermissionForUser", func(h int32) (*pb.BoolReply, error) {
+		return e.c.svc.AddPermissionForUser(ctx, &pb.PermissionRequest{EnforcerHandler: h, User: user, Permissions: permission}, e.c.writeOptions()...)
+	})
+}
+
+// DeletePermissionForUser takes the permission from the user, false if the user does not have it.
+func (e *Enforcer) DeletePermissionForUser(ctx context.Context, user string, permission ...string) (bool, error) {
+	return e.write(ctx, "DeletePermissionForUser", func(h int32) (*pb.BoolReply, error) {
+		return e.c.svc.DeletePermissionForUser(ctx, &pb.PermissionRequest{EnforcerHandler: h, User: user, Permissions: permission}, e.c.writeOptions()...)
+	})
+}
+
+// write calls a write RPC replying a BoolReply.
+func (e *Enforcer) write(ctx context.Context, method string, call func(h int32) (*pb.BoolReply, error)) (bool, error) {
+	h, err := e.Handle()
+	if err != nil {
+		return false, err
+	}
+
+	out, err := call(h)
+	if err := e.changed(h, method, err); err != nil {
+		return false, err
+	}
+	return out.Res, nil
+}
+
+// changed drops the cached decisions of the enforcer after a write, without waiting for
+// the policy change event. A failed write may have changed the policy too.
+func (e *Enforcer) changed(h int32, method string, err error) error {
+	if e.c.cache != nil {
+		e.c.cache.invalidate(h)
+	}
+	return wrapError(method, err)
+}
+
+func rules(out *pb.Array2DReply) [][]string {
+	res := make([][]string, 0, len(out.D2))
+	for _, d := range out.D2 {
+		res = append(res, d.D1)
+	}
+	return res
+}
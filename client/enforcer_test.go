@@ -0,0 +1,101 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/cicdi-go/casbin/handler"
+	microclient "github.com/micro/go-micro/client"
+)
+
+// localClient serves the calls of the client with a handler.Server in process, so the
+// requests the client builds are read by the real handlers.
+type localClient struct {
+	microclient.Client
+
+	s      *handler.Server
+	method string
+	req    interface{}
+}
+
+func (c *localClient) NewRequest(service string, method string, req interface{}, opts ...microclient.RequestOption) microclient.Request {
+	c.method, c.req = method, req
+	return nil
+}
+
+func (c *localClient) Call(ctx context.Context, req microclient.Request, rsp interface{}, opts ...microclient.CallOption) error {
+	m := reflect.ValueOf(c.s).MethodByName(strings.TrimPrefix(c.method, "Casbin."))
+	out := m.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(c.req), reflect.ValueOf(rsp)})
+	err, _ := out[0].Interface().(error)
+	return err
+}
+
+func newRBACEnforcer(t *testing.T) *Enforcer {
+	ctx := context.Background()
+	c := New("", &localClient{s: handler.NewServer(handler.DataDir("../models"))})
+
+	a, err := c.NewAdapter(ctx, AdapterConfig{AdapterName: "file", DriverName: "file", ConnectString: "rbac_policy.csv"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := c.NewEnforcer(ctx, "rbac", EnforcerConfig{ModelTemplate: "rbac", AdapterHandle: a})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestEnforcerRoles(t *testing.T) {
+	ctx := context.Background()
+	e := newRBACEnforcer(t)
+
+	users, err := e.GetUsersForRole(ctx, "data2_admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"alice"}; !reflect.DeepEqual(users, want) {
+		t.Errorf("GetUsersForRole: got %v, want %v", users, want)
+	}
+
+	roles, err := e.GetRolesForUser(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"data2_admin"}; !reflect.DeepEqual(roles, want) {
+		t.Errorf("GetRolesForUser: got %v, want %v", roles, want)
+	}
+
+	if _, err := e.AddRoleForUser(ctx, "bob", "data2_admin"); err != nil {
+		t.Fatal(err)
+	}
+	users, err = e.GetUsersForRole(ctx, "data2_admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(users)
+	if want := []string{"alice", "bob"}; !reflect.DeepEqual(users, want) {
+		t.Errorf("GetUsersForRole after AddRoleForUser: got %v, want %v", users, want)
+	}
+
+	ok, err := e.HasRoleForUser(ctx, "bob", "data2_admin")
+	if err != nil || !ok {
+		t.Errorf("HasRoleForUser: got %v, %v", ok, err)
+	}
+}
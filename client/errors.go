@@ -0,0 +1,109 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+
+	microerrors "github.com/micro/go-micro/errors"
+)
+
+// The ids of the errors of the service, see the ErrorID constants of its handler package.
+const (
+	errorIDNotFound      = "casbin.not_found"
+	errorIDQuotaExceeded = "casbin.quota_exceeded"
+	errorIDRateLimited   = "casbin.rate_limited"
+)
+
+// Error is an error replied by the service.
+type Error struct {
+	// Method is the RPC that failed.
+	Method string
+	// Id identifies the kind of error, e.g. "casbin.not_found".
+	Id string
+	// Code is the HTTP-like status code of the error.
+	Code int32
+	// Detail is the message of the service.
+	Detail string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("casbin: %s: %s (%d)", e.Method, e.Detail, e.Code)
+}
+
+// LoadError tells that NewEnforcer created the enforcer but could not load its policy
+// from the adapter, the enforcer can retry with LoadPolicy.
+type LoadError struct {
+	// Detail is the message of the service.
+	Detail string
+}
+
+func (e *LoadError) Error() string {
+	return "casbin: NewEnforcer: loading the policy: " + e.Detail
+}
+
+// wrapError turns the errors replied by the service into an *Error, leaving the
+// transport errors as they are.
+func wrapError(method string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if e, ok := err.(*microerrors.Error); ok {
+		return &Error{Method: method, Id: e.Id, Code: e.Code, Detail: e.Detail}
+	}
+	return err
+}
+
+func code(err error) int32 {
+	if e, ok := err.(*Error); ok {
+		return e.Code
+	}
+	return 0
+}
+
+// IsUnauthorized determines whether the call failed for lack of valid credentials.
+func IsUnauthorized(err error) bool {
+	return code(err) == 401
+}
+
+// IsForbidden determines whether the meta policy of the service denied the call.
+func IsForbidden(err error) bool {
+	return code(err) == 403
+}
+
+// IsTooLarge determines whether the request was over a size limit of the service.
+func IsTooLarge(err error) bool {
+	return code(err) == 413
+}
+
+// IsRateLimited determines whether the call was over a rate limit, the caller should
+// back off before retrying. Calls over a quota are not, see IsQuotaExceeded.
+func IsRateLimited(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.Code == 429 && e.Id == errorIDRateLimited
+}
+
+// IsNotFound determines whether the enforcer or adapter handle is unknown to the service.
+func IsNotFound(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.Code == 404 && e.Id == errorIDNotFound
+}
+
+// IsQuotaExceeded determines whether the call was over a quota of the tenant.
+func IsQuotaExceeded(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.Code == 429 && e.Id == errorIDQuotaExceeded
+}
@@ -0,0 +1,56 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"errors"
+	"testing"
+
+	microerrors "github.com/micro/go-micro/errors"
+)
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		notFound      bool
+		quotaExceeded bool
+		rateLimited   bool
+	}{
+		{"not found", microerrors.NotFound(errorIDNotFound, "enforcer 3 not found"), true, false, false},
+		{"other 404", microerrors.NotFound("go.micro.client", "service not found"), false, false, false},
+		{"quota", microerrors.New(errorIDQuotaExceeded, "too many enforcers", 429), false, true, false},
+		{"rate limit", microerrors.New(errorIDRateLimited, "rate limit exceeded", 429), false, false, true},
+		{"other 429", microerrors.New("go.micro.srv.casbin", "rate limit exceeded", 429), false, false, false},
+		{"transport", errors.New("connection refused"), false, false, false},
+	}
+
+	for _, tt := range tests {
+		err := wrapError("Enforce", tt.err)
+		if got := IsNotFound(err); got != tt.notFound {
+			t.Errorf("%s: IsNotFound got %v", tt.name, got)
+		}
+		if got := IsQuotaExceeded(err); got != tt.quotaExceeded {
+			t.Errorf("%s: IsQuotaExceeded got %v", tt.name, got)
+		}
+		if got := IsRateLimited(err); got != tt.rateLimited {
+			t.Errorf("%s: IsRateLimited got %v", tt.name, got)
+		}
+	}
+
+	if err := wrapError("Enforce", nil); err != nil {
+		t.Errorf("nil: got %v", err)
+	}
+}
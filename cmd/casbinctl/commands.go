@@ -0,0 +1,479 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+	"github.com/micro/cli"
+)
+
+func adapterCommand() cli.Command {
+	return cli.Command{
+		Name:  "adapter",
+		Usage: "Create and check adapters",
+		Subcommands: []cli.Command{
+			{
+				Name:  "new",
+				Usage: "Create an adapter and print its handle",
+				Flags: []cli.Flag{
+					cli.StringFlag{Name: "adapter", Usage: "Adapter family, inferred from the driver if empty"},
+					cli.StringFlag{Name: "driver", Usage: "Driver, e.g. mysql, bolt or file"},
+					cli.StringFlag{Name: "connect", Usage: "Connection string, may reference ${secret:name}"},
+					cli.StringSliceFlag{Name: "param", Usage: "Driver param as key=value"},
+				},
+				Action: action(func(c *cli.Context) error {
+					params := map[string]string{}
+					for _, kv := range c.StringSlice("param") {
+						i := strings.Index(kv, "=")
+						if i <= 0 {
+							return fmt.Errorf("invalid param %q, want key=value", kv)
+						}
+						params[kv[:i]] = kv[i+1:]
+					}
+
+					svc, ctx, err := service(c)
+					if err != nil {
+						return err
+					}
+					reply, err := svc.NewAdapter(ctx, &pb.NewAdapterRequest{
+						AdapterName:   c.String("adapter"),
+						DriverName:    c.String("driver"),
+						ConnectString: c.String("connect"),
+						Params:        params,
+					})
+					if err != nil {
+						return err
+					}
+					return output(c, reply, []string{"handle"}, [][]string{{strconv.Itoa(int(reply.Handler))}})
+				}),
+			},
+			{
+				Name:  "drivers",
+				Usage: "List the adapter drivers of the service",
+				Action: action(func(c *cli.Context) error {
+					svc, ctx, err := service(c)
+					if err != nil {
+						return err
+					}
+					reply, err := svc.ListAdapterDrivers(ctx, &pb.EmptyRequest{})
+					if err != nil {
+						return err
+					}
+
+					var rows [][]string
+					for _, d := range reply.Drivers {
+						rows = append(rows, []string{d.AdapterName, d.DriverName})
+					}
+					return output(c, reply, []string{"adapter", "driver"}, rows)
+				}),
+			},
+			{
+				Name:      "check",
+				Usage:     "Check the backend of an adapter",
+				ArgsUsage: "HANDLE",
+				Action: action(func(c *cli.Context) error {
+					h, err := handleArg(c, "adapter")
+					if err != nil {
+						return err
+					}
+					svc, ctx, err := service(c)
+					if err != nil {
+						return err
+					}
+					reply, err := svc.CheckAdapter(ctx, &pb.CheckAdapterRequest{AdapterHandle: h})
+					if err != nil {
+						return err
+					}
+					return output(c, reply, []string{"healthy", "message"}, [][]string{{strconv.FormatBool(reply.Healthy), reply.Message}})
+				}),
+			},
+		},
+	}
+}
+
+func enforcerCommand() cli.Command {
+	return cli.Command{
+		Name:  "enforcer",
+		Usage: "Create enforcers",
+		Subcommands: []cli.Command{
+			{
+				Name: "new",
+				Usage: "Create an enforcer and print its handle. With --policy and no --adapter, the policy " +
+					"file is imported into a new memory adapter",
+				Flags: []cli.Flag{
+					cli.StringFlag{Name: "model", Usage: "Model file"},
+					cli.StringFlag{Name: "template", Usage: "Built-in model, see enforcer templates"},
+					cli.IntFlag{Name: "adapter", Value: -1, Usage: "Adapter handle, -1 for none"},
+					cli.StringFlag{Name: "policy", Usage: "CSV policy file to import"},
+				},
+				Action: action(func(c *cli.Context) error {
+					in := &pb.NewEnforcerRequest{
+						ModelTemplate: c.String("template"),
+						AdapterHandle: int32(c.Int("adapter")),
+					}
+					if path := c.String("model"); path != "" {
+						data, err := ioutil.ReadFile(path)
+						if err != nil {
+							return err
+						}
+						in.ModelText = string(data)
+					}
+					if in.ModelText == "" && in.ModelTemplate == "" {
+						return fmt.Errorf("missing --model or --template")
+					}
+
+					var policy []byte
+					if path := c.String("policy"); path != "" {
+						var err error
+						if policy, err = ioutil.ReadFile(path); err != nil {
+							return err
+						}
+					}
+
+					svc, ctx, err := service(c)
+					if err != nil {
+						return err
+					}
+					// The service cannot delete enforcers or adapters, so the handles created
+					// before a failure are reported for the caller to reuse them.
+					created := false
+					if policy != nil && in.AdapterHandle == -1 {
+						a, err := svc.NewAdapter(ctx, &pb.NewAdapterRequest{AdapterName: "memory", DriverName: "memory"})
+						if err != nil {
+							return err
+						}
+						in.AdapterHandle = a.Handler
+						created = true
+					}
+
+					reply, err := svc.NewEnforcer(ctx, in)
+					if err != nil {
+						if created {
+							return fmt.Errorf("adapter %d created, creating the enforcer: %v", in.AdapterHandle, err)
+						}
+						return err
+					}
+					if policy != nil {
+						_, err := svc.ImportPolicy(ctx, &pb.ImportPolicyRequest{
+							EnforcerHandler: reply.Handler,
+							Format:          "csv",
+							Data:            string(policy),
+						})
+						if err != nil {
+							return fmt.Errorf("enforcer %d created with adapter %d, importing the policy: %v", reply.Handler, in.AdapterHandle, err)
+						}
+					}
+					if reply.LoadError != "" {
+						fmt.Fprintf(os.Stderr, "casbinctl: enforcer %d created, loading its policy: %s\n", reply.Handler, reply.LoadError)
+					}
+					return output(c, reply, []string{"handle"}, [][]string{{strconv.Itoa(int(reply.Handler))}})
+				}),
+			},
+			{
+				Name:  "templates",
+				Usage: "List the built-in models",
+				Action: action(func(c *cli.Context) error {
+					svc, ctx, err := service(c)
+					if err != nil {
+						return err
+					}
+					reply, err := svc.ListModelTemplates(ctx, &pb.EmptyRequest{})
+					if err != nil {
+						return err
+					}
+
+					var rows [][]string
+					for _, t := range reply.Templates {
+						rows = append(rows, []string{t.Name, t.Description})
+					}
+					return output(c, reply, []string{"name", "description"}, rows)
+				}),
+			},
+		},
+	}
+}
+
+func enforceCommand() cli.Command {
+	return cli.Command{
+		Name:      "enforce",
+		Usage:     "Check whether a request is allowed",
+		ArgsUsage: "HANDLE PARAM...",
+		Action: action(func(c *cli.Context) error {
+			h, err := handleArg(c, "enforcer")
+			if err != nil {
+				return err
+			}
+			svc, ctx, err := service(c)
+			if err != nil {
+				return err
+			}
+			reply, err := svc.Enforce(ctx, &pb.EnforceRequest{EnforcerHandler: h, Params: c.Args().Tail()})
+			if err != nil {
+				return err
+			}
+			return output(c, reply, []string{"allowed"}, [][]string{{strconv.FormatBool(reply.Res)}})
+		}),
+	}
+}
+
+// policyCommand edits the rules of the section sec, p or g.
+func policyCommand(name string, sec string) cli.Command {
+	ptype := cli.StringFlag{Name: "ptype", Value: sec, Usage: "Policy type"}
+	edit := func(add bool) func(c *cli.Context) error {
+		return func(c *cli.Context) error {
+			h, err := handleArg(c, "enforcer")
+			if err != nil {
+				return err
+			}
+			svc, ctx, err := service(c)
+			if err != nil {
+				return err
+			}
+
+			in := &pb.PolicyRequest{EnforcerHandler: h, PType: c.String("ptype"), Params: c.Args().Tail()}
+			var reply *pb.BoolReply
+			switch {
+			case sec == "p" && add:
+				reply, err = svc.AddNamedPolicy(ctx, in)
+			case sec == "p":
+				reply, err = svc.RemoveNamedPolicy(ctx, in)
+			case add:
+				reply, err = svc.AddNamedGroupingPolicy(ctx, in)
+			default:
+				reply, err = svc.RemoveNamedGroupingPolicy(ctx, in)
+			}
+			if err != nil {
+				return err
+			}
+			return output(c, reply, []string{"changed"}, [][]string{{strconv.FormatBool(reply.Res)}})
+		}
+	}
+
+	commands := []cli.Command{
+		{
+			Name:      "list",
+			Usage:     "List the rules",
+			ArgsUsage: "HANDLE",
+			Flags:     []cli.Flag{ptype},
+			Action: action(func(c *cli.Context) error {
+				h, err := handleArg(c, "enforcer")
+				if err != nil {
+					return err
+				}
+				svc, ctx, err := service(c)
+				if err != nil {
+					return err
+				}
+
+				in := &pb.PolicyRequest{EnforcerHandler: h, PType: c.String("ptype")}
+				var reply *pb.Array2DReply
+				if sec == "p" {
+					reply, err = svc.GetNamedPolicy(ctx, in)
+				} else {
+					reply, err = svc.GetNamedGroupingPolicy(ctx, in)
+				}
+				if err != nil {
+					return err
+				}
+
+				headers, rows := ruleRows(reply)
+				return output(c, reply, headers, rows)
+			}),
+		},
+		{
+			Name:      "add",
+			Usage:     "Add a rule",
+			ArgsUsage: "HANDLE FIELD...",
+			Flags:     []cli.Flag{ptype},
+			Action:    action(edit(true)),
+		},
+		{
+			Name:      "remove",
+			Usage:     "Remove a rule",
+			ArgsUsage: "HANDLE FIELD...",
+			Flags:     []cli.Flag{ptype},
+			Action:    action(edit(false)),
+		},
+	}
+	if sec == "p" {
+		commands = append(commands, importCommand(), exportCommand())
+	}
+
+	return cli.Command{
+		Name:        name,
+		Usage:       fmt.Sprintf("Edit the %s rules", sec),
+		Subcommands: commands,
+	}
+}
+
+func importCommand() cli.Command {
+	return cli.Command{
+		Name:      "import",
+		Usage:     "Import the p and g rules of a file",
+		ArgsUsage: "HANDLE FILE",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "format", Value: "csv", Usage: "Format of the file, csv, json or yaml"},
+			cli.StringFlag{Name: "mode", Value: "merge", Usage: "merge to add the rules, replace to replace the policy"},
+		},
+		Action: action(func(c *cli.Context) error {
+			h, err := handleArg(c, "enforcer")
+			if err != nil {
+				return err
+			}
+			if c.NArg() < 2 {
+				return fmt.Errorf("missing file")
+			}
+			data, err := ioutil.ReadFile(c.Args().Get(1))
+			if err != nil {
+				return err
+			}
+
+			svc, ctx, err := service(c)
+			if err != nil {
+				return err
+			}
+			reply, err := svc.ImportPolicy(ctx, &pb.ImportPolicyRequest{
+				EnforcerHandler: h,
+				Format:          c.String("format"),
+				Data:            string(data),
+				Mode:            c.String("mode"),
+			})
+			if err != nil {
+				return err
+			}
+			return output(c, reply, []string{"added", "skipped"}, [][]string{{strconv.Itoa(int(reply.Added)), strconv.Itoa(int(reply.Skipped))}})
+		}),
+	}
+}
+
+func exportCommand() cli.Command {
+	return cli.Command{
+		Name:      "export",
+		Usage:     "Print the p and g rules",
+		ArgsUsage: "HANDLE",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "format", Value: "csv", Usage: "csv, json or yaml"},
+		},
+		Action: action(func(c *cli.Context) error {
+			h, err := handleArg(c, "enforcer")
+			if err != nil {
+				return err
+			}
+			svc, ctx, err := service(c)
+			if err != nil {
+				return err
+			}
+			reply, err := svc.ExportPolicy(ctx, &pb.ExportPolicyRequest{EnforcerHandler: h, Format: c.String("format")})
+			if err != nil {
+				return err
+			}
+			return output(c, reply, nil, [][]string{{strings.TrimRight(reply.Data, "\n")}})
+		}),
+	}
+}
+
+func roleGraphCommand() cli.Command {
+	return cli.Command{
+		Name:      "role-graph",
+		Usage:     "Print the role graph, or its cycles, orphan roles and deep chains with --check",
+		ArgsUsage: "HANDLE",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "ptype", Value: "g", Usage: "Grouping policy type"},
+			cli.StringFlag{Name: "domain", Usage: "Domain of the roles"},
+			cli.StringFlag{Name: "format", Value: "dot", Usage: "json or dot"},
+			cli.BoolFlag{Name: "permissions", Usage: "Include the permissions of the roles"},
+			cli.BoolFlag{Name: "check", Usage: "Check the graph instead of printing it"},
+		},
+		Action: action(func(c *cli.Context) error {
+			h, err := handleArg(c, "enforcer")
+			if err != nil {
+				return err
+			}
+			svc, ctx, err := service(c)
+			if err != nil {
+				return err
+			}
+
+			in := &pb.RoleGraphRequest{
+				EnforcerHandler:    h,
+				PType:              c.String("ptype"),
+				Domain:             c.String("domain"),
+				Format:             c.String("format"),
+				IncludePermissions: c.Bool("permissions"),
+			}
+			if !c.Bool("check") {
+				reply, err := svc.ExportRoleGraph(ctx, in)
+				if err != nil {
+					return err
+				}
+				return output(c, reply, nil, [][]string{{strings.TrimRight(reply.Data, "\n")}})
+			}
+
+			reply, err := svc.CheckRoleGraph(ctx, in)
+			if err != nil {
+				return err
+			}
+			var rows [][]string
+			for _, p := range reply.Cycles {
+				rows = append(rows, []string{"cycle", strings.Join(p.Path, " -> ")})
+			}
+			for _, r := range reply.OrphanRoles {
+				rows = append(rows, []string{"orphan role", r})
+			}
+			for _, p := range reply.DeepChains {
+				rows = append(rows, []string{"deep chain", strings.Join(p.Path, " -> ")})
+			}
+			return output(c, reply, []string{"finding", "roles"}, rows)
+		}),
+	}
+}
+
+func analyzeCommand() cli.Command {
+	return cli.Command{
+		Name:      "analyze",
+		Usage:     "Run the policy analyzer",
+		ArgsUsage: "HANDLE",
+		Action: action(func(c *cli.Context) error {
+			h, err := handleArg(c, "enforcer")
+			if err != nil {
+				return err
+			}
+			svc, ctx, err := service(c)
+			if err != nil {
+				return err
+			}
+			reply, err := svc.AnalyzePolicy(ctx, &pb.EmptyRequest{Handler: h})
+			if err != nil {
+				return err
+			}
+
+			var rows [][]string
+			for _, f := range reply.Findings {
+				var rules []string
+				for _, r := range f.Rules {
+					rules = append(rules, strings.Join(r.D1, ", "))
+				}
+				rows = append(rows, []string{f.Kind, f.PType, f.Message, strings.Join(rules, "; ")})
+			}
+			return output(c, reply, []string{"kind", "ptype", "message", "rules"}, rows)
+		}),
+	}
+}
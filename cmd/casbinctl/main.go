@@ -0,0 +1,115 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command casbinctl operates the casbin service: it creates adapters and enforcers from
+// local files, checks requests, edits, imports and exports the policy, and shows the role
+// graph and the findings of the policy analyzer. It takes the go-micro flags, e.g.
+// --registry, to find the service.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+	"github.com/cicdi-go/casbin/version"
+	"github.com/micro/cli"
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/cmd"
+	"github.com/micro/go-micro/metadata"
+)
+
+func main() {
+	app := cmd.App()
+	app.Flags = append(app.Flags,
+		cli.StringFlag{
+			Name:   "service",
+			EnvVar: "CASBINCTL_SERVICE",
+			Value:  "go.micro.srv.casbin",
+			Usage:  "Registry name of the casbin service",
+		},
+		cli.StringFlag{
+			Name:   "output",
+			EnvVar: "CASBINCTL_OUTPUT",
+			Value:  "table",
+			Usage:  "Output format, table or json",
+		},
+		cli.StringFlag{
+			Name:   "token",
+			EnvVar: "CASBINCTL_TOKEN",
+			Usage:  "Bearer token authenticating the calls",
+		},
+		cli.StringSliceFlag{
+			Name:   "metadata",
+			EnvVar: "CASBINCTL_METADATA",
+			Usage:  "Metadata of the calls as key=value, e.g. X-Casbin-Principal=alice",
+		},
+	)
+	app.Commands = append(app.Commands, adapterCommand(), enforcerCommand(), enforceCommand(),
+		policyCommand("policy", "p"), policyCommand("grouping", "g"), roleGraphCommand(), analyzeCommand())
+
+	err := cmd.Init(
+		cmd.Name("casbinctl"),
+		cmd.Description("Command-line tool of the casbin service"),
+		cmd.Version(version.Version),
+	)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "casbinctl:", err)
+		os.Exit(1)
+	}
+}
+
+// action turns a command returning an error into a cli action exiting with status 1 on error.
+func action(fn func(c *cli.Context) error) func(c *cli.Context) {
+	return func(c *cli.Context) {
+		if err := fn(c); err != nil {
+			fmt.Fprintln(os.Stderr, "casbinctl:", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// service gets the client of the casbin service and the context of the calls.
+func service(c *cli.Context) (pb.CasbinService, context.Context, error) {
+	md := metadata.Metadata{}
+	for _, kv := range c.GlobalStringSlice("metadata") {
+		i := strings.Index(kv, "=")
+		if i <= 0 {
+			return nil, nil, fmt.Errorf("invalid metadata %q, want key=value", kv)
+		}
+		md[kv[:i]] = kv[i+1:]
+	}
+	if token := c.GlobalString("token"); token != "" {
+		md["Authorization"] = "Bearer " + token
+	}
+
+	ctx := metadata.NewContext(context.Background(), md)
+	return pb.NewCasbinService(c.GlobalString("service"), client.DefaultClient), ctx, nil
+}
+
+// handleArg parses the handle given as the first argument.
+func handleArg(c *cli.Context, what string) (int32, error) {
+	if c.NArg() < 1 {
+		return 0, fmt.Errorf("missing %s handle", what)
+	}
+
+	h, err := strconv.ParseInt(c.Args().First(), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s handle %q", what, c.Args().First())
+	}
+	return int32(h), nil
+}
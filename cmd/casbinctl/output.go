@@ -0,0 +1,66 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/micro/cli"
+)
+
+// output prints the reply of a call, as JSON with --output json, otherwise as a table
+// of rows under the headers.
+func output(c *cli.Context, reply proto.Message, headers []string, rows [][]string) error {
+	switch c.GlobalString("output") {
+	case "json":
+		m := jsonpb.Marshaler{EmitDefaults: true, Indent: "  "}
+		if err := m.Marshal(os.Stdout, reply); err != nil {
+			return err
+		}
+		fmt.Println()
+		return nil
+	case "table":
+	default:
+		return fmt.Errorf("unknown output format %q, want table or json", c.GlobalString("output"))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	if len(headers) > 0 {
+		fmt.Fprintln(w, strings.Join(headers, "\t"))
+	}
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// ruleRows turns policy rules into rows, with headers v0, v1... as wide as the widest rule.
+func ruleRows(reply *pb.Array2DReply) ([]string, [][]string) {
+	var headers []string
+	var rows [][]string
+	for _, d := range reply.D2 {
+		for len(headers) < len(d.D1) {
+			headers = append(headers, fmt.Sprintf("v%d", len(headers)))
+		}
+		rows = append(rows, d.D1)
+	}
+	return headers, rows
+}
@@ -0,0 +1,313 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gateway exposes the RPCs of the casbin service over HTTP/JSON. The calls go
+// through the go-micro client, so they run the same handlers and wrappers, and fail with
+// the same errors, as the RPC calls.
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/metadata"
+)
+
+// OpenAPIPath is the path of the OpenAPI document of the gateway.
+const OpenAPIPath = "/v1/openapi.json"
+
+var (
+	marshaler   = jsonpb.Marshaler{EmitDefaults: true}
+	unmarshaler = jsonpb.Unmarshaler{}
+)
+
+// Options configures a Gateway.
+type Options struct {
+	// Headers are the HTTP headers passed as metadata besides Authorization. Headers
+	// naming the caller or its tenant, like X-Casbin-Principal, should only be added
+	// when the clients of the gateway are trusted to set them.
+	Headers []string
+	// MaxBodyBytes is the length of the request bodies, 0 means no limit.
+	MaxBodyBytes int64
+}
+
+// Option sets an option of a Gateway.
+type Option func(*Options)
+
+// Headers adds HTTP headers to pass as metadata.
+func Headers(names ...string) Option {
+	return func(o *Options) {
+		o.Headers = append(o.Headers, names...)
+	}
+}
+
+// MaxBodyBytes sets the maximum length of the request bodies, it should be the batch
+// limit of the service, see ratelimit.Sizes.
+func MaxBodyBytes(n int64) Option {
+	return func(o *Options) {
+		o.MaxBodyBytes = n
+	}
+}
+
+// Gateway is the http.Handler of the HTTP/JSON API.
+type Gateway struct {
+	svc     reflect.Value
+	name    string
+	openAPI []byte
+	opts    Options
+	headers []string
+}
+
+// New creates the gateway of the service registered as name, called through c.
+func New(name string, c client.Client, opts ...Option) *Gateway {
+	g := &Gateway{svc: reflect.ValueOf(pb.NewCasbinService(name, c)), name: name}
+	for _, o := range opts {
+		o(&g.opts)
+	}
+
+	g.headers = []string{"Authorization"}
+	for _, name := range g.opts.Headers {
+		g.headers = append(g.headers, http.CanonicalHeaderKey(name))
+	}
+
+	for _, r := range routes {
+		if !g.svc.MethodByName(r.rpc).IsValid() {
+			panic("gateway: no RPC " + r.rpc)
+		}
+	}
+
+	svc := reflect.TypeOf((*pb.CasbinService)(nil)).Elem()
+	g.openAPI, _ = json.MarshalIndent(openAPI(name, svc), "", "  ")
+	return g
+}
+
+// ServeHTTP calls the RPC routed from the request. Its request message is decoded from
+// the JSON body, then from the query parameters, e.g. ?params=alice&params=data1 or
+// ?page.pageSize=10, then from the path. The Authorization header, and the headers of
+// the Headers option, are passed as metadata.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == OpenAPIPath && r.Method == "GET" {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(g.openAPI)
+		return
+	}
+
+	segments := splitPath(r.URL.Path)
+	pathFound := false
+	for _, rt := range routes {
+		vars, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			pathFound = true
+			continue
+		}
+
+		g.call(w, r, rt.rpc, vars)
+		return
+	}
+
+	if pathFound {
+		g.writeError(w, errors.New(g.name, "method not allowed", http.StatusMethodNotAllowed))
+		return
+	}
+	g.writeError(w, errors.NotFound(g.name, "no route for %s", r.URL.Path))
+}
+
+func (g *Gateway) call(w http.ResponseWriter, r *http.Request, rpc string, vars map[string]string) {
+	method := g.svc.MethodByName(rpc)
+	in := reflect.New(method.Type().In(1).Elem())
+	req := in.Interface().(proto.Message)
+
+	var body []byte
+	if r.Body != nil {
+		var err error
+		if g.opts.MaxBodyBytes > 0 {
+			r.Body = http.MaxBytesReader(w, r.Body, g.opts.MaxBodyBytes)
+		}
+		if body, err = ioutil.ReadAll(r.Body); err != nil {
+			if g.opts.MaxBodyBytes > 0 && int64(len(body)) >= g.opts.MaxBodyBytes {
+				g.writeError(w, errors.New(g.name, fmt.Sprintf("the body is larger than %d bytes", g.opts.MaxBodyBytes), http.StatusRequestEntityTooLarge))
+				return
+			}
+			g.writeError(w, errors.BadRequest(g.name, "body: %v", err))
+			return
+		}
+	}
+
+	if err := decode(r, body, req, vars); err != nil {
+		g.writeError(w, errors.BadRequest(g.name, "%v", err))
+		return
+	}
+
+	// Only the allowed headers are passed, so that the callers cannot name themselves or
+	// their tenant through metadata the service trusts.
+	md := metadata.Metadata{}
+	for _, name := range g.headers {
+		if value := r.Header.Get(name); value != "" {
+			md[name] = value
+		}
+	}
+	ctx := metadata.NewContext(r.Context(), md)
+
+	out := method.Call([]reflect.Value{reflect.ValueOf(ctx), in})
+	if err, _ := out[1].Interface().(error); err != nil {
+		g.writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := marshaler.Marshal(w, out[0].Interface().(proto.Message)); err != nil {
+		g.writeError(w, err)
+	}
+}
+
+func (g *Gateway) writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*errors.Error)
+	if !ok {
+		e = errors.InternalServerError(g.name, "%v", err).(*errors.Error)
+	}
+
+	code := int(e.Code)
+	if code < 400 || code > 599 {
+		code = http.StatusInternalServerError
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(e)
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// decode fills the request message from the body, the query and the path of r.
+func decode(r *http.Request, body []byte, req proto.Message, vars map[string]string) error {
+	if len(bytes.TrimSpace(body)) != 0 {
+		if err := unmarshaler.Unmarshal(bytes.NewReader(body), req); err != nil {
+			return fmt.Errorf("body: %v", err)
+		}
+	}
+
+	v := reflect.ValueOf(req).Elem()
+	for key, values := range r.URL.Query() {
+		if err := setField(v, strings.Split(key, "."), values); err != nil {
+			return fmt.Errorf("query %s: %v", key, err)
+		}
+	}
+
+	for name, value := range vars {
+		names := []string{name}
+		if name == "id" {
+			names = handleFields
+		}
+
+		set := false
+		for _, n := range names {
+			if _, ok := fieldByName(v, n); ok {
+				if err := setField(v, []string{n}, []string{value}); err != nil {
+					return fmt.Errorf("path %s: %v", name, err)
+				}
+				set = true
+				break
+			}
+		}
+		if !set {
+			return fmt.Errorf("path %s: no such field", name)
+		}
+	}
+
+	return nil
+}
+
+// fieldByName finds the field of a message by its JSON name.
+func fieldByName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if jsonName(t.Field(i)) == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func jsonName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "-" || strings.HasPrefix(f.Name, "XXX_") {
+		return ""
+	}
+	return name
+}
+
+// setField sets the field at path, a list of JSON names, from query values.
+func setField(v reflect.Value, path []string, values []string) error {
+	f, ok := fieldByName(v, path[0])
+	if !ok {
+		return fmt.Errorf("no such field")
+	}
+
+	if f.Kind() == reflect.Ptr && f.Type().Elem().Kind() == reflect.Struct {
+		if len(path) == 1 {
+			return fmt.Errorf("%s is a message", path[0])
+		}
+		if f.IsNil() {
+			f.Set(reflect.New(f.Type().Elem()))
+		}
+		return setField(f.Elem(), path[1:], values)
+	}
+	if len(path) > 1 {
+		return fmt.Errorf("%s is not a message", path[0])
+	}
+
+	if f.Kind() == reflect.Slice && f.Type().Elem().Kind() == reflect.String {
+		f.Set(reflect.ValueOf(append([]string(nil), values...)))
+		return nil
+	}
+	return setScalar(f, values[len(values)-1])
+}
+
+func setScalar(f reflect.Value, s string) error {
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		f.SetBool(b)
+	case reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetInt(n)
+	default:
+		return fmt.Errorf("cannot be set from the query")
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cicdi-go/casbin/handler"
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/metadata"
+)
+
+// fakeClient records the calls of the gateway and replies to Enforce with true.
+type fakeClient struct {
+	client.Client
+
+	md  metadata.Metadata
+	req interface{}
+}
+
+func (c *fakeClient) NewRequest(service string, method string, req interface{}, opts ...client.RequestOption) client.Request {
+	c.req = req
+	return nil
+}
+
+func (c *fakeClient) Call(ctx context.Context, req client.Request, rsp interface{}, opts ...client.CallOption) error {
+	c.md, _ = metadata.FromContext(ctx)
+	if out, ok := rsp.(*pb.BoolReply); ok {
+		out.Res = true
+	}
+	return nil
+}
+
+// localClient serves the calls of the gateway with a handler.Server in process.
+type localClient struct {
+	client.Client
+
+	s      *handler.Server
+	method string
+	req    interface{}
+}
+
+func (c *localClient) NewRequest(service string, method string, req interface{}, opts ...client.RequestOption) client.Request {
+	c.method, c.req = method, req
+	return nil
+}
+
+func (c *localClient) Call(ctx context.Context, req client.Request, rsp interface{}, opts ...client.CallOption) error {
+	m := reflect.ValueOf(c.s).MethodByName(strings.TrimPrefix(c.method, "Casbin."))
+	out := m.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(c.req), reflect.ValueOf(rsp)})
+	err, _ := out[0].Interface().(error)
+	return err
+}
+
+func enforce(g *Gateway, body string, header http.Header) *httptest.ResponseRecorder {
+	r := httptest.NewRequest("POST", "/v1/enforcers/3/enforce", strings.NewReader(body))
+	for name, values := range header {
+		r.Header[name] = values
+	}
+
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, r)
+	return w
+}
+
+func TestGatewayDecode(t *testing.T) {
+	c := &fakeClient{}
+	w := enforce(New("test", c), `{"params": ["alice", "data1", "read"]}`, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got %d: %s", w.Code, w.Body)
+	}
+
+	want := &pb.EnforceRequest{EnforcerHandler: 3, Params: []string{"alice", "data1", "read"}}
+	if !reflect.DeepEqual(c.req, want) {
+		t.Errorf("got request %v, want %v", c.req, want)
+	}
+	if !strings.Contains(w.Body.String(), `"res": true`) && !strings.Contains(w.Body.String(), `"res":true`) {
+		t.Errorf("got body %s", w.Body)
+	}
+}
+
+func TestGatewayHeaders(t *testing.T) {
+	header := http.Header{
+		"Authorization":      {"Bearer token"},
+		"X-Casbin-Principal": {"alice"},
+		"X-Casbin-Tenant":    {"acme"},
+	}
+
+	tests := []struct {
+		name string
+		opts []Option
+		want metadata.Metadata
+	}{
+		{"default", nil, metadata.Metadata{"Authorization": "Bearer token"}},
+		{"allowed", []Option{Headers("x-casbin-principal")}, metadata.Metadata{"Authorization": "Bearer token", "X-Casbin-Principal": "alice"}},
+	}
+
+	for _, tt := range tests {
+		c := &fakeClient{}
+		if w := enforce(New("test", c, tt.opts...), `{}`, header); w.Code != http.StatusOK {
+			t.Fatalf("%s: got %d: %s", tt.name, w.Code, w.Body)
+		}
+		if !reflect.DeepEqual(c.md, tt.want) {
+			t.Errorf("%s: got metadata %v, want %v", tt.name, c.md, tt.want)
+		}
+	}
+}
+
+func TestGatewayMaxBodyBytes(t *testing.T) {
+	c := &fakeClient{}
+	g := New("test", c, MaxBodyBytes(32))
+
+	if w := enforce(g, `{"params": ["alice"]}`, nil); w.Code != http.StatusOK {
+		t.Errorf("small body: got %d: %s", w.Code, w.Body)
+	}
+	if w := enforce(g, `{"params": ["`+strings.Repeat("a", 64)+`"]}`, nil); w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("large body: got %d: %s", w.Code, w.Body)
+	}
+}
+
+func TestGatewayGetUsersForRole(t *testing.T) {
+	ctx := context.Background()
+	s := handler.NewServer(handler.DataDir("../models"))
+
+	a := &pb.NewAdapterReply{}
+	if err := s.NewAdapter(ctx, &pb.NewAdapterRequest{AdapterName: "file", DriverName: "file", ConnectString: "rbac_policy.csv"}, a); err != nil {
+		t.Fatal(err)
+	}
+	e := &pb.NewEnforcerReply{}
+	if err := s.NewEnforcer(ctx, &pb.NewEnforcerRequest{ModelTemplate: "rbac", AdapterHandle: a.Handler}, e); err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest("GET", "/v1/enforcers/"+strconv.Itoa(int(e.Handler))+"/roles/data2_admin/users", nil)
+	w := httptest.NewRecorder()
+	New("test", &localClient{s: s}).ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got %d: %s", w.Code, w.Body)
+	}
+
+	var out struct {
+		Array []string `json:"array"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &out); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"alice"}; !reflect.DeepEqual(out.Array, want) {
+		t.Errorf("got %v, want %v", out.Array, want)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"reflect"
+	"strings"
+)
+
+// openAPI generates the OpenAPI 3 document of the routes. The schemas are derived from
+// the messages generated from casbin.proto, svc being the CasbinService interface.
+func openAPI(name string, svc reflect.Type) map[string]interface{} {
+	schemas := map[string]interface{}{
+		"Error": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"id":     map[string]interface{}{"type": "string"},
+				"code":   map[string]interface{}{"type": "integer", "format": "int32"},
+				"detail": map[string]interface{}{"type": "string"},
+				"status": map[string]interface{}{"type": "string"},
+			},
+		},
+	}
+
+	paths := map[string]interface{}{}
+	for _, r := range routes {
+		m, _ := svc.MethodByName(r.rpc)
+		in := m.Type.In(1).Elem()
+		out := m.Type.Out(0).Elem()
+
+		op := map[string]interface{}{
+			"operationId": r.rpc,
+			"responses": map[string]interface{}{
+				"200": map[string]interface{}{
+					"description": "OK",
+					"content":     jsonContent(schemaRef(out, schemas)),
+				},
+				"default": map[string]interface{}{
+					"description": "Error",
+					"content":     jsonContent(map[string]interface{}{"$ref": "#/components/schemas/Error"}),
+				},
+			},
+		}
+
+		var params []interface{}
+		pathFields := map[string]bool{}
+		for _, segment := range splitPath(r.path) {
+			if !strings.HasPrefix(segment, "{") {
+				continue
+			}
+			v := strings.Trim(segment, "{}")
+			schema := map[string]interface{}{"type": "string"}
+			if v == "id" {
+				schema = map[string]interface{}{"type": "integer", "format": "int32"}
+				for _, f := range handleFields {
+					pathFields[f] = true
+				}
+			}
+			pathFields[v] = true
+			params = append(params, map[string]interface{}{"name": v, "in": "path", "required": true, "schema": schema})
+		}
+
+		if r.method == "GET" || r.method == "DELETE" {
+			params = append(params, queryParams(in, "", pathFields, schemas)...)
+		} else {
+			op["requestBody"] = map[string]interface{}{"content": jsonContent(schemaRef(in, schemas))}
+		}
+		if len(params) > 0 {
+			op["parameters"] = params
+		}
+
+		item, ok := paths[r.path].(map[string]interface{})
+		if !ok {
+			item = map[string]interface{}{}
+			paths[r.path] = item
+		}
+		item[strings.ToLower(r.method)] = op
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.0",
+		"info": map[string]interface{}{
+			"title":   name,
+			"version": "v1",
+		},
+		"paths":      paths,
+		"components": map[string]interface{}{"schemas": schemas},
+	}
+}
+
+func jsonContent(schema interface{}) map[string]interface{} {
+	return map[string]interface{}{"application/json": map[string]interface{}{"schema": schema}}
+}
+
+// schemaRef adds the schema of the message t to schemas and returns a reference to it.
+func schemaRef(t reflect.Type, schemas map[string]interface{}) map[string]interface{} {
+	ref := map[string]interface{}{"$ref": "#/components/schemas/" + t.Name()}
+	if _, ok := schemas[t.Name()]; ok {
+		return ref
+	}
+
+	properties := map[string]interface{}{}
+	schema := map[string]interface{}{"type": "object", "properties": properties}
+	// Set before the fields, for the messages referencing themselves.
+	schemas[t.Name()] = schema
+	for i := 0; i < t.NumField(); i++ {
+		if name := jsonName(t.Field(i)); name != "" {
+			properties[name] = schemaOf(t.Field(i).Type, schemas)
+		}
+	}
+
+	return ref
+}
+
+func schemaOf(t reflect.Type, schemas map[string]interface{}) map[string]interface{} {
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int32:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Int64:
+		// jsonpb encodes 64-bit integers as strings.
+		return map[string]interface{}{"type": "string", "format": "int64"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": schemaOf(t.Elem(), schemas)}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": schemaOf(t.Elem(), schemas)}
+	case reflect.Ptr:
+		return schemaRef(t.Elem(), schemas)
+	}
+
+	return map[string]interface{}{}
+}
+
+// queryParams lists the fields of the message t as query parameters, the fields of
+// nested messages being prefixed with the name of their field.
+func queryParams(t reflect.Type, prefix string, skip map[string]bool, schemas map[string]interface{}) []interface{} {
+	var params []interface{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := jsonName(f)
+		if name == "" || (prefix == "" && skip[name]) {
+			continue
+		}
+
+		if f.Type.Kind() == reflect.Ptr {
+			params = append(params, queryParams(f.Type.Elem(), prefix+name+".", skip, schemas)...)
+			continue
+		}
+		params = append(params, map[string]interface{}{"name": prefix + name, "in": "query", "schema": schemaOf(f.Type, schemas)})
+	}
+
+	return params
+}
@@ -0,0 +1,135 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+// route maps an HTTP method and path to an RPC. The {id} segment of a path sets the
+// handle field of the request, the other segments the field of the same name.
+type route struct {
+	method string
+	path   string
+	rpc    string
+}
+
+// routes is the REST-style API of the gateway, in the order of casbin.proto. The routes
+// are part of the API: add new ones, never change existing ones.
+var routes = []route{
+	{"POST", "/v1/enforcers", "NewEnforcer"},
+	{"POST", "/v1/adapters", "NewAdapter"},
+	{"GET", "/v1/adapters/drivers", "ListAdapterDrivers"},
+	{"GET", "/v1/adapters/{id}/health", "CheckAdapter"},
+	{"GET", "/v1/model-templates", "ListModelTemplates"},
+
+	{"POST", "/v1/enforcers/{id}/enforce", "Enforce"},
+	{"GET", "/v1/enforcers/{id}/who-can", "WhoCan"},
+	{"GET", "/v1/enforcers/{id}/allowed", "ListAllowed"},
+
+	{"POST", "/v1/enforcers/{id}/policy/load", "LoadPolicy"},
+	{"POST", "/v1/enforcers/{id}/policy/load-filtered", "LoadFilteredPolicy"},
+	{"POST", "/v1/enforcers/{id}/policy/save", "SavePolicy"},
+	{"GET", "/v1/enforcers/{id}/policy/export", "ExportPolicy"},
+	{"POST", "/v1/enforcers/{id}/policy/import", "ImportPolicy"},
+
+	{"POST", "/v1/enforcers/{id}/policy", "AddPolicy"},
+	{"POST", "/v1/enforcers/{id}/named-policy", "AddNamedPolicy"},
+	{"DELETE", "/v1/enforcers/{id}/policy", "RemovePolicy"},
+	{"DELETE", "/v1/enforcers/{id}/named-policy", "RemoveNamedPolicy"},
+	{"DELETE", "/v1/enforcers/{id}/policy/filtered", "RemoveFilteredPolicy"},
+	{"DELETE", "/v1/enforcers/{id}/named-policy/filtered", "RemoveFilteredNamedPolicy"},
+	{"GET", "/v1/enforcers/{id}/policy", "GetPolicy"},
+	{"GET", "/v1/enforcers/{id}/named-policy", "GetNamedPolicy"},
+	{"GET", "/v1/enforcers/{id}/policy/filtered", "GetFilteredPolicy"},
+	{"GET", "/v1/enforcers/{id}/named-policy/filtered", "GetFilteredNamedPolicy"},
+
+	{"POST", "/v1/enforcers/{id}/grouping-policy", "AddGroupingPolicy"},
+	{"POST", "/v1/enforcers/{id}/named-grouping-policy", "AddNamedGroupingPolicy"},
+	{"DELETE", "/v1/enforcers/{id}/grouping-policy", "RemoveGroupingPolicy"},
+	{"DELETE", "/v1/enforcers/{id}/named-grouping-policy", "RemoveNamedGroupingPolicy"},
+	{"DELETE", "/v1/enforcers/{id}/grouping-policy/filtered", "RemoveFilteredGroupingPolicy"},
+	{"DELETE", "/v1/enforcers/{id}/named-grouping-policy/filtered", "RemoveFilteredNamedGroupingPolicy"},
+	{"GET", "/v1/enforcers/{id}/grouping-policy", "GetGroupingPolicy"},
+	{"GET", "/v1/enforcers/{id}/named-grouping-policy", "GetNamedGroupingPolicy"},
+	{"GET", "/v1/enforcers/{id}/grouping-policy/filtered", "GetFilteredGroupingPolicy"},
+	{"GET", "/v1/enforcers/{id}/named-grouping-policy/filtered", "GetFilteredNamedGroupingPolicy"},
+
+	{"GET", "/v1/enforcers/{id}/subjects", "GetAllSubjects"},
+	{"GET", "/v1/enforcers/{id}/named-subjects", "GetAllNamedSubjects"},
+	{"GET", "/v1/enforcers/{id}/objects", "GetAllObjects"},
+	{"GET", "/v1/enforcers/{id}/named-objects", "GetAllNamedObjects"},
+	{"GET", "/v1/enforcers/{id}/actions", "GetAllActions"},
+	{"GET", "/v1/enforcers/{id}/named-actions", "GetAllNamedActions"},
+	{"GET", "/v1/enforcers/{id}/roles", "GetAllRoles"},
+	{"GET", "/v1/enforcers/{id}/named-roles", "GetAllNamedRoles"},
+
+	{"GET", "/v1/enforcers/{id}/policy/exists", "HasPolicy"},
+	{"GET", "/v1/enforcers/{id}/named-policy/exists", "HasNamedPolicy"},
+	{"GET", "/v1/enforcers/{id}/grouping-policy/exists", "HasGroupingPolicy"},
+	{"GET", "/v1/enforcers/{id}/named-grouping-policy/exists", "HasNamedGroupingPolicy"},
+
+	{"GET", "/v1/enforcers/{id}/users/{user}/roles", "GetRolesForUser"},
+	{"GET", "/v1/enforcers/{id}/roles/{role}/users", "GetUsersForRole"},
+	{"GET", "/v1/enforcers/{id}/users/{user}/roles/{role}", "HasRoleForUser"},
+	{"POST", "/v1/enforcers/{id}/users/{user}/roles", "AddRoleForUser"},
+	{"DELETE", "/v1/enforcers/{id}/users/{user}/roles/{role}", "DeleteRoleForUser"},
+	{"DELETE", "/v1/enforcers/{id}/users/{user}/roles", "DeleteRolesForUser"},
+	{"DELETE", "/v1/enforcers/{id}/users/{user}", "DeleteUser"},
+	{"DELETE", "/v1/enforcers/{id}/roles/{role}", "DeleteRole"},
+	{"DELETE", "/v1/enforcers/{id}/permissions", "DeletePermission"},
+	{"POST", "/v1/enforcers/{id}/users/{user}/permissions", "AddPermissionForUser"},
+	{"DELETE", "/v1/enforcers/{id}/users/{user}/permission", "DeletePermissionForUser"},
+	{"DELETE", "/v1/enforcers/{id}/users/{user}/permissions", "DeletePermissionsForUser"},
+	{"GET", "/v1/enforcers/{id}/users/{user}/permissions", "GetPermissionsForUser"},
+	{"GET", "/v1/enforcers/{id}/users/{user}/permissions/exists", "HasPermissionForUser"},
+
+	{"GET", "/v1/enforcers/{id}/domains/{domain}/users/{user}/roles", "GetRolesForUserInDomain"},
+	{"GET", "/v1/enforcers/{id}/domains/{domain}/roles/{role}/users", "GetUsersForRoleInDomain"},
+	{"POST", "/v1/enforcers/{id}/domains/{domain}/users/{user}/roles", "AddRoleForUserInDomain"},
+	{"DELETE", "/v1/enforcers/{id}/domains/{domain}/users/{user}/roles/{role}", "DeleteRoleForUserInDomain"},
+	{"GET", "/v1/enforcers/{id}/domains/{domain}/users/{user}/permissions", "GetPermissionsForUserInDomain"},
+	{"GET", "/v1/enforcers/{id}/domains", "GetAllDomains"},
+
+	{"GET", "/v1/enforcers/{id}/users/{user}/implicit-roles", "GetImplicitRolesForUser"},
+	{"GET", "/v1/enforcers/{id}/users/{user}/implicit-permissions", "GetImplicitPermissionsForUser"},
+	{"GET", "/v1/enforcers/{id}/implicit-users", "GetImplicitUsersForPermission"},
+
+	{"GET", "/v1/enforcers/{id}/role-graph", "ExportRoleGraph"},
+	{"GET", "/v1/enforcers/{id}/role-graph/check", "CheckRoleGraph"},
+
+	{"GET", "/v1/enforcers/{id}/analysis", "AnalyzePolicy"},
+
+	{"GET", "/v1/health", "Health"},
+	{"GET", "/v1/readiness", "Readiness"},
+	{"GET", "/v1/version", "Version"},
+}
+
+// handleFields are the fields the {id} segment may set, in order of preference.
+var handleFields = []string{"enforcerHandler", "handler", "adapterHandle"}
+
+// match matches a path with the pattern of a route, returning the values of its segments.
+func (r route) match(segments []string) (map[string]string, bool) {
+	pattern := splitPath(r.path)
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+
+	vars := map[string]string{}
+	for i, p := range pattern {
+		if len(p) > 2 && p[0] == '{' && p[len(p)-1] == '}' {
+			vars[p[1:len(p)-1]] = segments[i]
+		} else if p != segments[i] {
+			return nil, false
+		}
+	}
+	return vars, true
+}
@@ -15,40 +15,128 @@
 package handler
 
 import (
-	"errors"
-	pb "github.com/cicdi-go/casbin/proto/casbin"
+	"context"
+	"fmt"
+	"time"
 
 	"github.com/casbin/casbin/persist"
-	"github.com/casbin/casbin/persist/file-adapter"
-	"github.com/casbin/gorm-adapter"
-	//_ "github.com/jinzhu/gorm/dialects/mssql"
-	//_ "github.com/jinzhu/gorm/dialects/mysql"
-	//_ "github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/cicdi-go/casbin/adapter"
+	"github.com/cicdi-go/casbin/auth"
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+	"github.com/cicdi-go/casbin/secret"
 )
 
-var errDriverName = errors.New("invalid DriverName")
-
-func newAdapter(in *pb.NewAdapterRequest) (persist.Adapter, error) {
-	var a persist.Adapter
-	supportDriverNames := [...]string{"file", "mysql", "postgres", "sqlite3", "mssql"}
-
-	switch in.DriverName {
-	case "file":
-		a = fileadapter.NewAdapter(in.ConnectString)
-	default:
-		var support = false
-		for _, driverName := range supportDriverNames {
-			if driverName == in.DriverName {
-				support = true
-				break
+// newAdapter creates the adapter of a request. The secrets referenced by the connection
+// string and params of a database driver are resolved within the tenant of the caller, and
+// redacted from then on. The other drivers cannot reference secrets, their connection
+// string is data that can be read back, e.g. the policy of the text driver.
+func (s *Server) newAdapter(ctx context.Context, in *pb.NewAdapterRequest) (persist.Adapter, error) {
+	d, err := adapter.Lookup(in.AdapterName, in.DriverName)
+	if err != nil {
+		return nil, err
+	}
+
+	connectString := in.ConnectString
+	params := make(map[string]string, len(in.Params))
+	if adapter.IsDSN(d) {
+		resolver := secret.Scoped(s.opts.SecretResolver, auth.TenantFromContext(ctx))
+
+		var values []string
+		if connectString, values, err = secret.Expand(in.ConnectString, resolver); err != nil {
+			return nil, err
+		}
+		s.opts.Redactor.Add(values...)
+
+		for name, value := range in.Params {
+			if params[name], values, err = secret.Expand(value, resolver); err != nil {
+				return nil, err
 			}
+			s.opts.Redactor.Add(values...)
 		}
-		if support {
-			a = gormadapter.NewAdapter(in.DriverName, in.ConnectString)
-			break
+	} else {
+		if secret.Contains(connectString) {
+			return nil, fmt.Errorf("the %s driver cannot reference secrets", d.DriverName)
+		}
+		for name, value := range in.Params {
+			if secret.Contains(value) {
+				return nil, fmt.Errorf("the %s driver cannot reference secrets", d.DriverName)
+			}
+			params[name] = value
 		}
-		return nil, errDriverName
 	}
 
+	cfg := adapter.Config{
+		DriverName:    in.DriverName,
+		ConnectString: connectString,
+		Params:        params,
+		DataDir:       s.opts.DataDir,
+	}
+
+	if in.Pool != nil {
+		cfg.Pool.MaxOpenConns = int(in.Pool.MaxOpenConns)
+		cfg.Pool.MaxIdleConns = int(in.Pool.MaxIdleConns)
+
+		if cfg.Pool.ConnMaxLifetime, err = parseDuration("connMaxLifetime", in.Pool.ConnMaxLifetime); err != nil {
+			return nil, err
+		}
+		if cfg.Pool.Timeout, err = parseDuration("timeout", in.Pool.Timeout); err != nil {
+			return nil, err
+		}
+	}
+
+	a, err := adapter.New(d.AdapterName, cfg)
+	if err != nil {
+		return nil, s.redact(err)
+	}
 	return a, nil
 }
+
+func parseDuration(name string, value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return d, nil
+}
+
+// ListAdapterDrivers gets the adapter drivers compiled into the service.
+func (s *Server) ListAdapterDrivers(ctx context.Context, in *pb.EmptyRequest, out *pb.AdapterDriversReply) error {
+	for _, d := range adapter.Drivers() {
+		out.Drivers = append(out.Drivers, &pb.AdapterDriver{AdapterName: d.AdapterName, DriverName: d.DriverName})
+	}
+
+	return nil
+}
+
+// CheckAdapter pings the backend of an adapter. An unreachable backend is reported in
+// the reply, not as an error.
+func (s *Server) CheckAdapter(ctx context.Context, in *pb.CheckAdapterRequest, out *pb.CheckAdapterReply) error {
+	a, err := s.getAdapter(ctx, int(in.AdapterHandle))
+	if err != nil {
+		return err
+	}
+
+	out.Healthy = true
+	if err := adapter.Ping(ctx, a); err != nil {
+		out.Healthy = false
+		out.Message = s.redact(err).Error()
+	}
+
+	return nil
+}
+
+// CheckAdapters pings the backends of every adapter created so far, in every tenant,
+// returning the first failure.
+func (s *Server) CheckAdapters(ctx context.Context) error {
+	for _, st := range s.adapterStatuses(ctx, "", true) {
+		if !st.Healthy {
+			return fmt.Errorf("%s: %s", adapterName(st.Tenant, st.Handle), st.Message)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,276 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/casbin/casbin/model"
+	"github.com/casbin/casbin/util"
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+var matchFunctionRegex = regexp.MustCompile(`\b(keyMatch2?|regexMatch)\s*\(\s*r[._](\w+)\s*,\s*p[._](\w+)\s*\)`)
+
+var matchFunctions = map[string]func(string, string) bool{
+	"keyMatch":   util.KeyMatch,
+	"keyMatch2":  util.KeyMatch2,
+	"regexMatch": util.RegexMatch,
+}
+
+// policyAnalyzer looks for rules that can be cleaned up in a policy.
+type policyAnalyzer struct {
+	m        model.Model
+	graph    *roleGraph
+	findings []*pb.PolicyFinding
+}
+
+// AnalyzePolicy reports the duplicate, shadowed and redundant rules, the allow/deny conflicts,
+// the rules referencing subjects or roles used nowhere else and the roles granting no permission.
+func (s *Server) AnalyzePolicy(ctx context.Context, in *pb.EmptyRequest, out *pb.AnalyzePolicyReply) error {
+	e, err := s.getEnforcer(ctx, int(in.Handler))
+	if err != nil {
+		return err
+	}
+
+	m := e.GetModel()
+	a := &policyAnalyzer{m: m, graph: newRoleGraph(m, subjectGroupingType(m, "p"), "")}
+
+	for _, ptype := range sortedKeys(m["p"]) {
+		a.checkDuplicates("p", ptype)
+		a.checkPolicy(ptype)
+	}
+	for _, gtype := range sortedKeys(m["g"]) {
+		a.checkDuplicates("g", gtype)
+	}
+	a.checkReferences()
+
+	out.Findings = a.findings
+	return nil
+}
+
+func (a *policyAnalyzer) report(kind string, ptype string, message string, rules ...[]string) {
+	finding := &pb.PolicyFinding{Kind: kind, PType: ptype, Message: message}
+	for _, rule := range rules {
+		finding.Rules = append(finding.Rules, &pb.Array2DReplyD{D1: rule})
+	}
+
+	a.findings = append(a.findings, finding)
+}
+
+func (a *policyAnalyzer) checkDuplicates(sec string, ptype string) {
+	count := map[string]int{}
+	var keys []string
+	rules := map[string][]string{}
+
+	for _, rule := range a.m[sec][ptype].Policy {
+		key := strings.Join(rule, ", ")
+		if count[key] == 0 {
+			keys = append(keys, key)
+			rules[key] = rule
+		}
+		count[key]++
+	}
+
+	for _, key := range keys {
+		if count[key] > 1 {
+			duplicates := make([][]string, count[key])
+			for i := range duplicates {
+				duplicates[i] = rules[key]
+			}
+			a.report("duplicate", ptype, fmt.Sprintf("%s appears %d times", key, count[key]), duplicates...)
+		}
+	}
+}
+
+// checkPolicy looks for rules covered by broader rules of the same subject or of its roles,
+// and for allow rules overlapping deny rules.
+func (a *policyAnalyzer) checkPolicy(ptype string) {
+	policy := a.m["p"][ptype].Policy
+	functions := map[int]func(string, string) bool{}
+	for _, match := range matchFunctionRegex.FindAllStringSubmatch(a.m["m"]["m"].Value, -1) {
+		if index := policyFieldIndex(a.m, ptype, match[3]); index != -1 {
+			functions[index] = matchFunctions[match[1]]
+		}
+	}
+
+	eft := policyFieldIndex(a.m, ptype, "eft")
+	skip := map[int]bool{0: true, eft: true}
+
+	// covers determines whether every request matched by narrow is also matched by broad,
+	// leaving aside the subject and the effect.
+	covers := func(broad []string, narrow []string) bool {
+		if len(broad) != len(narrow) {
+			return false
+		}
+		for i := range narrow {
+			if skip[i] || narrow[i] == broad[i] {
+				continue
+			}
+			if fn, ok := functions[i]; !ok || !fn(narrow[i], broad[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	sameEffect := func(rule1 []string, rule2 []string) bool {
+		return eft == -1 || (eft < len(rule1) && eft < len(rule2) && rule1[eft] == rule2[eft])
+	}
+
+	bySubject := map[string][]int{}
+	for i, rule := range policy {
+		if len(rule) > 0 {
+			bySubject[rule[0]] = append(bySubject[rule[0]], i)
+		}
+	}
+
+	for i, rule := range policy {
+		if len(rule) == 0 {
+			continue
+		}
+
+		shadowed := false
+		for _, j := range bySubject[rule[0]] {
+			other := policy[j]
+			if i != j && sameEffect(rule, other) && covers(other, rule) && !covers(rule, other) {
+				a.report("shadowed", ptype, fmt.Sprintf("%s is shadowed by %s", strings.Join(rule, ", "), strings.Join(other, ", ")), rule, other)
+				shadowed = true
+				break
+			}
+		}
+		if shadowed {
+			continue
+		}
+
+		for _, role := range a.graph.ancestors(rule[0]) {
+			found := false
+			for _, j := range bySubject[role.name] {
+				other := policy[j]
+				if sameEffect(rule, other) && covers(other, rule) {
+					a.report("redundant", ptype, fmt.Sprintf("%s is already granted through %s", strings.Join(rule, ", "), strings.Join(role.path, " -> ")), rule, other)
+					found = true
+					break
+				}
+			}
+			if found {
+				break
+			}
+		}
+	}
+
+	if eft == -1 {
+		return
+	}
+
+	reported := map[[2]int]bool{}
+	for i, rule := range policy {
+		if eft >= len(rule) || rule[eft] != "allow" {
+			continue
+		}
+
+		related := []string{rule[0]}
+		for _, role := range a.graph.ancestors(rule[0]) {
+			related = append(related, role.name)
+		}
+		for _, user := range a.graph.descendants(rule[0]) {
+			related = append(related, user.name)
+		}
+
+		for _, subject := range related {
+			for _, j := range bySubject[subject] {
+				other := policy[j]
+				if eft >= len(other) || other[eft] != "deny" || reported[[2]int{i, j}] {
+					continue
+				}
+				if covers(other, rule) || covers(rule, other) {
+					reported[[2]int{i, j}] = true
+					a.report("conflict", ptype, fmt.Sprintf("%s conflicts with %s", strings.Join(rule, ", "), strings.Join(other, ", ")), rule, other)
+				}
+			}
+		}
+	}
+}
+
+// checkReferences looks for roles that grant no permission and, in models with roles,
+// for rules whose subject shows up nowhere else in the policy.
+func (a *policyAnalyzer) checkReferences() {
+	gtype := subjectGroupingType(a.m, "p")
+
+	for _, name := range a.graph.names() {
+		if !a.graph.isRole(name) || hasPermissions(a.m, name, "") {
+			continue
+		}
+
+		inherited := false
+		for _, role := range a.graph.ancestors(name) {
+			if hasPermissions(a.m, role.name, "") {
+				inherited = true
+				break
+			}
+		}
+		if inherited {
+			continue
+		}
+
+		assignments := a.m.GetFilteredPolicy("g", gtype, 1, name)
+		if len(a.graph.parents[name]) == 0 {
+			a.report("dangling_role", gtype, fmt.Sprintf("role %s has no permissions and inherits no role", name), assignments...)
+		} else {
+			a.report("empty_role", gtype, fmt.Sprintf("role %s grants no permissions, directly or through inheritance", name), assignments...)
+		}
+	}
+
+	if len(a.m["g"]) == 0 {
+		return
+	}
+
+	count := map[string]int{}
+	for _, ast := range a.m["p"] {
+		for _, rule := range ast.Policy {
+			if len(rule) > 0 {
+				count[rule[0]]++
+			}
+		}
+	}
+	for _, ast := range a.m["g"] {
+		for _, rule := range ast.Policy {
+			for _, name := range rule {
+				count[name]++
+			}
+		}
+	}
+
+	for _, ptype := range sortedKeys(a.m["p"]) {
+		for _, rule := range a.m["p"][ptype].Policy {
+			if len(rule) > 0 && count[rule[0]] == 1 {
+				a.report("dangling_subject", ptype, fmt.Sprintf("subject %s shows up in no other rule", rule[0]), rule)
+			}
+		}
+	}
+}
+
+func sortedKeys(astMap model.AssertionMap) []string {
+	keys := make([]string, 0, len(astMap))
+	for key := range astMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
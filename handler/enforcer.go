@@ -15,54 +15,151 @@
 package handler
 
 import (
-	"errors"
+	"sync"
 
 	"context"
 	"github.com/casbin/casbin"
 	pb "github.com/cicdi-go/casbin/proto/casbin"
 	"github.com/casbin/casbin/persist"
+	microerrors "github.com/micro/go-micro/errors"
+	"github.com/cicdi-go/casbin/adapter"
+	"github.com/cicdi-go/casbin/auth"
+	"github.com/cicdi-go/casbin/ratelimit"
 )
 
+// The ids of the micro errors returned by the handlers, which clients can rely on.
+const (
+	// ErrorIDInvalidArgument is the id of the errors for malformed requests, code 400.
+	ErrorIDInvalidArgument = "casbin.invalid_argument"
+	// ErrorIDNotFound is the id of the errors for unknown enforcer or adapter handles, code 404.
+	ErrorIDNotFound = "casbin.not_found"
+	// ErrorIDQuotaExceeded is the id of the errors for calls over a quota of the tenant, code 429.
+	ErrorIDQuotaExceeded = "casbin.quota_exceeded"
+	// ErrorIDRateLimited is the id of the errors for calls over a rate limit, code 429.
+	ErrorIDRateLimited = ratelimit.ErrorIDRateLimited
+	// ErrorIDTooLarge is the id of the errors for requests over a size limit, code 413.
+	ErrorIDTooLarge = ratelimit.ErrorIDTooLarge
+)
+
+// invalidArgument gets the error of a malformed request, which go-micro would otherwise
+// reply as an internal error.
+func invalidArgument(format string, a ...interface{}) error {
+	return microerrors.BadRequest(ErrorIDInvalidArgument, format, a...)
+}
+
 // Server is used to implement proto.CasbinServer.
 type Server struct {
+	opts Options
+
+	mu      sync.RWMutex
+	tenants map[string]*tenant
+}
+
+// tenant holds the enforcers and adapters created by the callers of a tenant. Handles
+// are only valid within their tenant.
+type tenant struct {
 	enforcerMap map[int]*casbin.Enforcer
 	adapterMap  map[int]persist.Adapter
+	requests    *ratelimit.Bucket
+	// loadErrors holds the result of the last load of the policy of every enforcer.
+	loadErrors map[int]error
 }
 
-func NewServer() *Server {
-	s := Server{}
+func NewServer(opts ...Option) *Server {
+	s := Server{opts: newOptions(opts...)}
 
-	s.enforcerMap = map[int]*casbin.Enforcer{}
-	s.adapterMap = map[int]persist.Adapter{}
+	s.tenants = map[string]*tenant{}
 
 	return &s
 }
 
-func (s *Server) getEnforcer(handle int) (*casbin.Enforcer, error) {
-	if _, ok := s.enforcerMap[handle]; ok {
-		return s.enforcerMap[handle], nil
+// getTenant gets the tenant of the caller, see auth.TenantFromContext, creating it on first use.
+func (s *Server) getTenant(ctx context.Context) *tenant {
+	name := auth.TenantFromContext(ctx)
+
+	s.mu.RLock()
+	t, ok := s.tenants[name]
+	s.mu.RUnlock()
+	if ok {
+		return t
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if t, ok := s.tenants[name]; ok {
+		return t
+	}
+
+	q := s.quota(name)
+	t = &tenant{enforcerMap: map[int]*casbin.Enforcer{}, adapterMap: map[int]persist.Adapter{}, loadErrors: map[int]error{}}
+	if q.RequestsPerSecond > 0 {
+		t.requests = ratelimit.NewBucket(q.RequestsPerSecond, q.Burst)
+	}
+	s.tenants[name] = t
+
+	return t
+}
+
+func (s *Server) getEnforcer(ctx context.Context, handle int) (*casbin.Enforcer, error) {
+	t := s.getTenant(ctx)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := t.enforcerMap[handle]; ok {
+		return t.enforcerMap[handle], nil
 	} else {
-		return nil, errors.New("enforcer not found")
+		return nil, microerrors.NotFound(ErrorIDNotFound, "enforcer %d not found", handle)
 	}
 }
 
-func (s *Server) getAdapter(handle int) (persist.Adapter, error) {
-	if _, ok := s.adapterMap[handle]; ok {
-		return s.adapterMap[handle], nil
+func (s *Server) getAdapter(ctx context.Context, handle int) (persist.Adapter, error) {
+	t := s.getTenant(ctx)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := t.adapterMap[handle]; ok {
+		return t.adapterMap[handle], nil
 	} else {
-		return nil, errors.New("adapter not found")
+		return nil, microerrors.NotFound(ErrorIDNotFound, "adapter %d not found", handle)
 	}
 }
 
-func (s *Server) addEnforcer(e *casbin.Enforcer) int {
-	cnt := len(s.enforcerMap)
-	s.enforcerMap[cnt] = e
-	return cnt
+func (s *Server) addEnforcer(ctx context.Context, e *casbin.Enforcer) (int, error) {
+	t := s.getTenant(ctx)
+	q := s.quota(auth.TenantFromContext(ctx))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cnt := len(t.enforcerMap)
+	if q.MaxEnforcers > 0 && cnt >= q.MaxEnforcers {
+		return 0, quotaExceeded("at most %d enforcers", q.MaxEnforcers)
+	}
+
+	t.enforcerMap[cnt] = e
+	return cnt, nil
 }
 
-func (s *Server) addAdapter(a persist.Adapter) int {
-	cnt := len(s.adapterMap)
-	s.adapterMap[cnt] = a
+// setLoaded records the result of loading the policy of an enforcer, see Health.
+func (s *Server) setLoaded(ctx context.Context, handle int, err error) {
+	t := s.getTenant(ctx)
+
+	s.mu.Lock()
+	t.loadErrors[handle] = err
+	s.mu.Unlock()
+}
+
+func (s *Server) addAdapter(ctx context.Context, a persist.Adapter) int {
+	t := s.getTenant(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cnt := len(t.adapterMap)
+	t.adapterMap[cnt] = a
 	return cnt
 }
 
@@ -72,39 +169,59 @@ func (s *Server) NewEnforcer(ctx context.Context, in *pb.NewEnforcerRequest, out
 
 	if in.AdapterHandle != -1 {
 		var err error
-		a, err = s.getAdapter(int(in.AdapterHandle))
+		a, err = s.getAdapter(ctx, int(in.AdapterHandle))
 		if err != nil {
 			out = &pb.NewEnforcerReply{Handler: 0}
 			return err
 		}
 	}
 
-	if a == nil {
-		e = casbin.NewEnforcer(casbin.NewModel(in.ModelText))
-	} else {
-		e = casbin.NewEnforcer(casbin.NewModel(in.ModelText), a)
+	if in.ModelText != "" && in.ModelTemplate != "" {
+		return microerrors.BadRequest(ErrorIDInvalidArgument, "set either modelText or modelTemplate, not both")
+	}
+	modelText := in.ModelText
+	if in.ModelTemplate != "" {
+		var err error
+		modelText, err = getModelTemplate(in.ModelTemplate)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The policy is loaded here rather than by casbin.NewEnforcer, which drops the error.
+	var loadErr error
+	e = casbin.NewEnforcer(casbin.NewModel(modelText))
+	if a != nil {
+		e.SetAdapter(a)
+		loadErr = e.LoadPolicy()
 	}
-	h := s.addEnforcer(e)
+	h, err := s.addEnforcer(ctx, e)
+	if err != nil {
+		return err
+	}
+	s.setLoaded(ctx, h, loadErr)
 
-	out = &pb.NewEnforcerReply{Handler: int32(h)}
+	out.Handler = int32(h)
+	if loadErr != nil {
+		out.LoadError = s.redact(loadErr).Error()
+	}
 	return nil
 }
 
 func (s *Server) NewAdapter(ctx context.Context, in *pb.NewAdapterRequest, out *pb.NewAdapterReply) error {
-	a, err := newAdapter(in)
+	a, err := s.newAdapter(ctx, in)
 	if err != nil {
-		out = nil
 		return err
 	}
 
-	h := s.addAdapter(a)
+	h := s.addAdapter(ctx, a)
 
-	out = &pb.NewAdapterReply{Handler: int32(h)}
+	out.Handler = int32(h)
 	return nil
 }
 
 func (s *Server) Enforce(ctx context.Context, in *pb.EnforceRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		out = &pb.BoolReply{Res: false}
 		return err
@@ -124,25 +241,44 @@ func (s *Server) Enforce(ctx context.Context, in *pb.EnforceRequest, out *pb.Boo
 
 	m.Value = sourceValue
 
-	out = &pb.BoolReply{Res: res}
+	out.Res = res
 	return nil
 }
 
 func (s *Server) LoadPolicy(ctx context.Context, in *pb.EmptyRequest, out *pb.EmptyReply) error {
-	e, err := s.getEnforcer(int(in.Handler))
+	e, err := s.getEnforcer(ctx, int(in.Handler))
 	if err != nil {
 		out = &pb.EmptyReply{}
 		return err
 	}
 
 	err = e.LoadPolicy()
+	s.setLoaded(ctx, int(in.Handler), err)
 
 	out = &pb.EmptyReply{}
 	return err
 }
 
+// LoadFilteredPolicy replaces the policy with the rules of the adapter matching any of the
+// filters. A filtered policy cannot be saved back to the adapter.
+func (s *Server) LoadFilteredPolicy(ctx context.Context, in *pb.LoadFilteredPolicyRequest, out *pb.EmptyReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	filters := make([]adapter.Filter, 0, len(in.Filters))
+	for _, f := range in.Filters {
+		filters = append(filters, adapter.Filter{PType: f.PType, FieldIndex: int(f.FieldIndex), FieldValues: f.FieldValues})
+	}
+
+	err = e.LoadFilteredPolicy(filters)
+	s.setLoaded(ctx, int(in.EnforcerHandler), err)
+	return err
+}
+
 func (s *Server) SavePolicy(ctx context.Context, in *pb.EmptyRequest, out *pb.EmptyReply) error {
-	e, err := s.getEnforcer(int(in.Handler))
+	e, err := s.getEnforcer(ctx, int(in.Handler))
 	if err != nil {
 		out = &pb.EmptyReply{}
 		return err
@@ -0,0 +1,70 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cicdi-go/casbin/auth"
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+	"github.com/micro/go-log"
+	"github.com/micro/go-micro"
+	"github.com/micro/go-micro/server"
+)
+
+// DefaultPolicyTopic is the topic the policy change events are published to.
+const DefaultPolicyTopic = "go.micro.srv.casbin.policy"
+
+// PublishChanges is a handler wrapper publishing a PolicyChangeEvent after every successful
+// write RPC on an enforcer, so that the clients caching decisions can drop them. Failing to
+// publish is logged, the RPC still succeeds.
+func PublishChanges(p micro.Publisher) server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			if err := fn(ctx, req, rsp); err != nil {
+				return err
+			}
+
+			method := req.Method()
+			if i := strings.LastIndex(method, "."); i != -1 {
+				method = method[i+1:]
+			}
+			if auth.ClassOf(method) != auth.Write || method == "SavePolicy" {
+				return nil
+			}
+
+			var handle int32
+			switch r := req.Request().(type) {
+			case interface{ GetEnforcerHandler() int32 }:
+				handle = r.GetEnforcerHandler()
+			case interface{ GetHandler() int32 }:
+				handle = r.GetHandler()
+			default:
+				return nil
+			}
+
+			ev := &pb.PolicyChangeEvent{
+				Tenant:          auth.TenantFromContext(ctx),
+				EnforcerHandler: handle,
+				Method:          method,
+			}
+			if err := p.Publish(ctx, ev); err != nil {
+				log.Logf("publishing the change of enforcer %d: %v", handle, err)
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+// +build ignore
+
+// gen_model_templates writes the text of the models/*_model.conf files into
+// model_template_gen.go, run it with go generate.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	dir := flag.String("models", "../models", "directory of the model files")
+	out := flag.String("out", "model_template_gen.go", "generated file")
+	flag.Parse()
+
+	paths, err := filepath.Glob(filepath.Join(*dir, "*_model.conf"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	sort.Strings(paths)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_model_templates.go from the models directory. DO NOT EDIT.\n\n")
+	b.WriteString("package handler\n\n")
+	b.WriteString("// modelTexts holds the text of every model template by name.\n")
+	b.WriteString("var modelTexts = map[string]string{\n")
+	for _, path := range paths {
+		text, err := ioutil.ReadFile(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		name := strings.TrimSuffix(filepath.Base(path), "_model.conf")
+		fmt.Fprintf(&b, "%q: %s,\n", name, literal(strings.TrimSpace(string(text))))
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// literal quotes text as a raw string when it can, which keeps the models readable.
+func literal(text string) string {
+	if strings.ContainsAny(text, "`\r") {
+		return strconv.Quote(text)
+	}
+
+	return "`" + text + "`"
+}
@@ -0,0 +1,263 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/casbin/casbin/persist"
+	"github.com/cicdi-go/casbin/adapter"
+	"github.com/cicdi-go/casbin/auth"
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+	"github.com/cicdi-go/casbin/version"
+	"github.com/golang/protobuf/jsonpb"
+)
+
+// Version gets the build of the service.
+func (s *Server) Version(ctx context.Context, in *pb.EmptyRequest, out *pb.VersionReply) error {
+	*out = *versionReply()
+	return nil
+}
+
+func versionReply() *pb.VersionReply {
+	return &pb.VersionReply{
+		Version:   version.Version,
+		Commit:    version.Commit,
+		BuildDate: version.BuildDate,
+		GoVersion: runtime.Version(),
+	}
+}
+
+// Health reports the load state and size of the policy of every enforcer, and the health
+// of every adapter, of the tenant of the caller.
+func (s *Server) Health(ctx context.Context, in *pb.EmptyRequest, out *pb.HealthReply) error {
+	*out = *s.health(ctx, auth.TenantFromContext(ctx), false)
+	return nil
+}
+
+// Readiness reports whether the enforcers of the tenant of the caller have loaded their
+// policy and their adapters are healthy.
+func (s *Server) Readiness(ctx context.Context, in *pb.EmptyRequest, out *pb.ReadinessReply) error {
+	*out = *readiness(s.health(ctx, auth.TenantFromContext(ctx), false))
+	return nil
+}
+
+// health reports on the enforcers and adapters of one tenant, or of all the tenants.
+func (s *Server) health(ctx context.Context, tenant string, all bool) *pb.HealthReply {
+	reply := &pb.HealthReply{
+		Status:   "ok",
+		Version:  versionReply(),
+		Adapters: s.adapterStatuses(ctx, tenant, all),
+	}
+
+	s.mu.RLock()
+	for name, t := range s.tenants {
+		if !all && name != tenant {
+			continue
+		}
+
+		for h, e := range t.enforcerMap {
+			st := &pb.EnforcerStatus{Tenant: name, Handle: int32(h), Loaded: true, Filtered: e.IsFiltered()}
+			if err := t.loadErrors[h]; err != nil {
+				st.Loaded = false
+				st.Error = s.redact(err).Error()
+			}
+
+			m := e.GetModel()
+			for _, ast := range m["p"] {
+				st.PolicySize += int32(len(ast.Policy))
+			}
+			for _, ast := range m["g"] {
+				st.GroupingPolicySize += int32(len(ast.Policy))
+			}
+			reply.Enforcers = append(reply.Enforcers, st)
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(reply.Enforcers, func(i, j int) bool {
+		a, b := reply.Enforcers[i], reply.Enforcers[j]
+		if a.Tenant != b.Tenant {
+			return a.Tenant < b.Tenant
+		}
+		return a.Handle < b.Handle
+	})
+
+	for _, st := range reply.Enforcers {
+		if !st.Loaded {
+			reply.Status = "degraded"
+		}
+	}
+	for _, st := range reply.Adapters {
+		if !st.Healthy {
+			reply.Status = "degraded"
+		}
+	}
+
+	return reply
+}
+
+// adapterStatuses pings the backends of the adapters of one tenant, or of all the tenants.
+func (s *Server) adapterStatuses(ctx context.Context, tenant string, all bool) []*pb.AdapterStatus {
+	type entry struct {
+		tenant string
+		handle int
+		a      persist.Adapter
+	}
+
+	var entries []entry
+	s.mu.RLock()
+	for name, t := range s.tenants {
+		if !all && name != tenant {
+			continue
+		}
+		for h, a := range t.adapterMap {
+			entries = append(entries, entry{tenant: name, handle: h, a: a})
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].tenant != entries[j].tenant {
+			return entries[i].tenant < entries[j].tenant
+		}
+		return entries[i].handle < entries[j].handle
+	})
+
+	statuses := make([]*pb.AdapterStatus, 0, len(entries))
+	for _, en := range entries {
+		st := &pb.AdapterStatus{Tenant: en.tenant, Handle: int32(en.handle), Healthy: true}
+		if err := adapter.Ping(ctx, en.a); err != nil {
+			st.Healthy = false
+			st.Message = s.redact(err).Error()
+		}
+		statuses = append(statuses, st)
+	}
+
+	return statuses
+}
+
+func readiness(health *pb.HealthReply) *pb.ReadinessReply {
+	reply := &pb.ReadinessReply{Ready: true}
+	for _, st := range health.Enforcers {
+		if !st.Loaded {
+			reply.Reasons = append(reply.Reasons, fmt.Sprintf("%s: policy not loaded: %s", enforcerName(st.Tenant, st.Handle), st.Error))
+		}
+	}
+	for _, st := range health.Adapters {
+		if !st.Healthy {
+			reply.Reasons = append(reply.Reasons, fmt.Sprintf("%s: %s", adapterName(st.Tenant, st.Handle), st.Message))
+		}
+	}
+	reply.Ready = len(reply.Reasons) == 0
+
+	return reply
+}
+
+func enforcerName(tenant string, handle int32) string {
+	if tenant == "" {
+		return fmt.Sprintf("enforcer %d", handle)
+	}
+	return fmt.Sprintf("enforcer %d of tenant %s", handle, tenant)
+}
+
+func adapterName(tenant string, handle int32) string {
+	if tenant == "" {
+		return fmt.Sprintf("adapter %d", handle)
+	}
+	return fmt.Sprintf("adapter %d of tenant %s", handle, tenant)
+}
+
+// readinessTTL is how long /readyz answers the same readiness, so that frequent probes
+// don't ping every database, and readinessTimeout bounds the pings of a check.
+const (
+	readinessTTL     = 5 * time.Second
+	readinessTimeout = 3 * time.Second
+)
+
+// HealthHandler serves the aggregate state of the service over HTTP, for orchestrators:
+// /healthz answers 200 with the build as long as the process serves, /readyz answers 200
+// when ready and 503 otherwise with counts of the failing enforcers and adapters, and
+// /version answers the build. It is not authenticated, so it names no tenant or enforcer.
+func (s *Server) HealthHandler() http.Handler {
+	m := jsonpb.Marshaler{EmitDefaults: true, Indent: "  "}
+
+	var (
+		mu      sync.Mutex
+		ready   *pb.ReadinessReply
+		checked time.Time
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		m.Marshal(w, &pb.HealthReply{Status: "ok", Version: versionReply()})
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		if ready == nil || time.Since(checked) > readinessTTL {
+			// Not the context of the request, a probe giving up must not be cached.
+			ctx, cancel := context.WithTimeout(context.Background(), readinessTimeout)
+			ready = aggregateReadiness(s.health(ctx, "", true))
+			checked = time.Now()
+			cancel()
+		}
+		reply := ready
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if !reply.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		m.Marshal(w, reply)
+	})
+	mux.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		m.Marshal(w, versionReply())
+	})
+
+	return mux
+}
+
+// aggregateReadiness is the readiness of all the tenants without their details.
+func aggregateReadiness(health *pb.HealthReply) *pb.ReadinessReply {
+	var enforcers, adapters int
+	for _, st := range health.Enforcers {
+		if !st.Loaded {
+			enforcers++
+		}
+	}
+	for _, st := range health.Adapters {
+		if !st.Healthy {
+			adapters++
+		}
+	}
+
+	reply := &pb.ReadinessReply{Ready: enforcers == 0 && adapters == 0}
+	if enforcers > 0 {
+		reply.Reasons = append(reply.Reasons, fmt.Sprintf("%d enforcers without a loaded policy", enforcers))
+	}
+	if adapters > 0 {
+		reply.Reasons = append(reply.Reasons, fmt.Sprintf("%d unhealthy adapters", adapters))
+	}
+
+	return reply
+}
@@ -0,0 +1,64 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// downAdapter is an adapter whose backend is down.
+type downAdapter struct {
+	failingAdapter
+
+	pings int
+}
+
+func (a *downAdapter) Ping(ctx context.Context) error {
+	a.pings++
+	return errors.New("connection refused")
+}
+
+func TestHealthHandler(t *testing.T) {
+	s := NewServer()
+	a := &downAdapter{}
+	s.addAdapter(context.Background(), a)
+	h := s.HealthHandler()
+
+	get := func(path string) int {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		return w.Code
+	}
+
+	if code := get("/healthz"); code != http.StatusOK {
+		t.Errorf("/healthz: got %d, want %d", code, http.StatusOK)
+	}
+	if a.pings != 0 {
+		t.Errorf("/healthz pinged the adapter %d times", a.pings)
+	}
+
+	for i := 0; i < 2; i++ {
+		if code := get("/readyz"); code != http.StatusServiceUnavailable {
+			t.Errorf("/readyz: got %d, want %d", code, http.StatusServiceUnavailable)
+		}
+	}
+	if a.pings != 1 {
+		t.Errorf("/readyz pinged the adapter %d times, want once within %v", a.pings, readinessTTL)
+	}
+}
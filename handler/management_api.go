@@ -33,6 +33,25 @@ func (s *Server) wrapPlainPolicy(policy [][]string) *pb.Array2DReply {
 	return policyReply
 }
 
+// wrapPagedPolicy wraps the rules like wrapPlainPolicy, paging them if page is set.
+func (s *Server) wrapPagedPolicy(policy [][]string, page *pb.PageOptions) (*pb.Array2DReply, error) {
+	if page == nil {
+		policyReply := s.wrapPlainPolicy(policy)
+		policyReply.Total = int32(len(policy))
+		return policyReply, nil
+	}
+
+	res, next, total, err := pagePolicy(policy, page)
+	if err != nil {
+		return nil, err
+	}
+
+	policyReply := s.wrapPlainPolicy(res)
+	policyReply.NextPageToken = next
+	policyReply.Total = int32(total)
+	return policyReply, nil
+}
+
 // GetAllSubjects gets the list of subjects that show up in the current policy.
 func (s *Server) GetAllSubjects(ctx context.Context, in *pb.EmptyRequest, out *pb.ArrayReply) error {
 	return s.GetAllNamedSubjects(ctx, &pb.SimpleGetRequest{EnforcerHandler: in.Handler, PType: "p"}, out)
@@ -40,12 +59,12 @@ func (s *Server) GetAllSubjects(ctx context.Context, in *pb.EmptyRequest, out *p
 
 // GetAllNamedSubjects gets the list of subjects that show up in the current named policy.
 func (s *Server) GetAllNamedSubjects(ctx context.Context, in *pb.SimpleGetRequest, out *pb.ArrayReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.ArrayReply{Array: e.GetModel().GetValuesForFieldInPolicy("p", in.PType, 0)}
+	out.Array = e.GetModel().GetValuesForFieldInPolicy("p", in.PType, 0)
 	return nil
 }
 
@@ -56,12 +75,12 @@ func (s *Server) GetAllObjects(ctx context.Context, in *pb.EmptyRequest, out *pb
 
 // GetAllNamedObjects gets the list of objects that show up in the current named policy.
 func (s *Server) GetAllNamedObjects(ctx context.Context, in *pb.SimpleGetRequest, out *pb.ArrayReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.ArrayReply{Array: e.GetModel().GetValuesForFieldInPolicy("p", in.PType, 1)}
+	out.Array = e.GetModel().GetValuesForFieldInPolicy("p", in.PType, 1)
 	return nil
 }
 
@@ -72,12 +91,12 @@ func (s *Server) GetAllActions(ctx context.Context, in *pb.EmptyRequest, out *pb
 
 // GetAllNamedActions gets the list of actions that show up in the current named policy.
 func (s *Server) GetAllNamedActions(ctx context.Context, in *pb.SimpleGetRequest, out *pb.ArrayReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.ArrayReply{Array: e.GetModel().GetValuesForFieldInPolicy("p", in.PType, 2)}
+	out.Array = e.GetModel().GetValuesForFieldInPolicy("p", in.PType, 2)
 	return nil
 }
 
@@ -88,28 +107,33 @@ func (s *Server) GetAllRoles(ctx context.Context, in *pb.EmptyRequest, out *pb.A
 
 // GetAllNamedRoles gets the list of roles that show up in the current named policy.
 func (s *Server) GetAllNamedRoles(ctx context.Context, in *pb.SimpleGetRequest, out *pb.ArrayReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.ArrayReply{Array: e.GetModel().GetValuesForFieldInPolicy("g", in.PType, 1)}
+	out.Array = e.GetModel().GetValuesForFieldInPolicy("g", in.PType, 1)
 	return nil
 }
 
 // GetPolicy gets all the authorization rules in the policy.
-func (s *Server) GetPolicy(ctx context.Context, in *pb.EmptyRequest, out *pb.Array2DReply) error {
-	return s.GetNamedPolicy(ctx, &pb.PolicyRequest{EnforcerHandler: in.Handler, PType: "p"}, out)
+func (s *Server) GetPolicy(ctx context.Context, in *pb.ListRequest, out *pb.Array2DReply) error {
+	return s.GetNamedPolicy(ctx, &pb.PolicyRequest{EnforcerHandler: in.Handler, PType: "p", Page: in.Page}, out)
 }
 
 // GetNamedPolicy gets all the authorization rules in the named policy.
 func (s *Server) GetNamedPolicy(ctx context.Context, in *pb.PolicyRequest, out *pb.Array2DReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = s.wrapPlainPolicy(e.GetModel().GetPolicy("p", in.PType))
+	res, err := s.wrapPagedPolicy(e.GetModel().GetPolicy("p", in.PType), in.Page)
+	if err != nil {
+		return err
+	}
+
+	*out = *res
 	return nil
 }
 
@@ -122,28 +146,38 @@ func (s *Server) GetFilteredPolicy(ctx context.Context, in *pb.FilteredPolicyReq
 
 // GetFilteredNamedPolicy gets all the authorization rules in the named policy, field filters can be specified.
 func (s *Server) GetFilteredNamedPolicy(ctx context.Context, in *pb.FilteredPolicyRequest, out *pb.Array2DReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	res, err := s.wrapPagedPolicy(e.GetModel().GetFilteredPolicy("p", in.PType, int(in.FieldIndex), in.FieldValues...), in.Page)
 	if err != nil {
 		return err
 	}
 
-	out = s.wrapPlainPolicy(e.GetModel().GetFilteredPolicy("p", in.PType, int(in.FieldIndex), in.FieldValues...))
+	*out = *res
 	return nil
 }
 
 // GetGroupingPolicy gets all the role inheritance rules in the policy.
-func (s *Server) GetGroupingPolicy(ctx context.Context, in *pb.EmptyRequest, out *pb.Array2DReply) error {
-	return s.GetNamedGroupingPolicy(ctx, &pb.PolicyRequest{EnforcerHandler: in.Handler, PType: "g"}, out)
+func (s *Server) GetGroupingPolicy(ctx context.Context, in *pb.ListRequest, out *pb.Array2DReply) error {
+	return s.GetNamedGroupingPolicy(ctx, &pb.PolicyRequest{EnforcerHandler: in.Handler, PType: "g", Page: in.Page}, out)
 }
 
 // GetNamedGroupingPolicy gets all the role inheritance rules in the policy.
 func (s *Server) GetNamedGroupingPolicy(ctx context.Context, in *pb.PolicyRequest, out *pb.Array2DReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = s.wrapPlainPolicy(e.GetModel().GetPolicy("g", in.PType))
+	res, err := s.wrapPagedPolicy(e.GetModel().GetPolicy("g", in.PType), in.Page)
+	if err != nil {
+		return err
+	}
+
+	*out = *res
 	return nil
 }
 
@@ -156,12 +190,17 @@ func (s *Server) GetFilteredGroupingPolicy(ctx context.Context, in *pb.FilteredP
 
 // GetFilteredNamedGroupingPolicy gets all the role inheritance rules in the policy, field filters can be specified.
 func (s *Server) GetFilteredNamedGroupingPolicy(ctx context.Context, in *pb.FilteredPolicyRequest, out *pb.Array2DReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	res, err := s.wrapPagedPolicy(e.GetModel().GetFilteredPolicy("g", in.PType, int(in.FieldIndex), in.FieldValues...), in.Page)
 	if err != nil {
 		return err
 	}
 
-	out = s.wrapPlainPolicy(e.GetModel().GetFilteredPolicy("g", in.PType, int(in.FieldIndex), in.FieldValues...))
+	*out = *res
 	return nil
 }
 
@@ -172,12 +211,12 @@ func (s *Server) HasPolicy(ctx context.Context, in *pb.PolicyRequest, out *pb.Bo
 
 // HasNamedPolicy determines whether a named authorization rule exists.
 func (s *Server) HasNamedPolicy(ctx context.Context, in *pb.PolicyRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.GetModel().HasPolicy("p", in.PType, in.Params)}
+	out.Res = e.GetModel().HasPolicy("p", in.PType, in.Params)
 	return nil
 }
 
@@ -190,12 +229,12 @@ func (s *Server) HasGroupingPolicy(ctx context.Context, in *pb.PolicyRequest, ou
 
 // HasNamedGroupingPolicy determines whether a named role inheritance rule exists.
 func (s *Server) HasNamedGroupingPolicy(ctx context.Context, in *pb.PolicyRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.GetModel().HasPolicy("g", in.PType, in.Params)}
+	out.Res = e.GetModel().HasPolicy("g", in.PType, in.Params)
 	return nil
 }
 
@@ -206,58 +245,62 @@ func (s *Server) AddPolicy(ctx context.Context, in *pb.PolicyRequest, out *pb.Bo
 }
 
 func (s *Server) AddNamedPolicy(ctx context.Context, in *pb.PolicyRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.AddNamedPolicy(in.PType, in.Params)}
-	return err
+	if err := s.checkRules(ctx, e, 1); err != nil {
+		return err
+	}
+
+	out.Res = e.AddNamedPolicy(in.PType, in.Params)
+	return nil
 }
 
 func (s *Server) RemovePolicy(ctx context.Context, in *pb.PolicyRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
 	res := e.RemovePolicy(in.Params)
 
-	out = &pb.BoolReply{Res: res}
+	out.Res = res
 	return err
 }
 
 func (s *Server) RemoveNamedPolicy(ctx context.Context, in *pb.PolicyRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
 	res := e.RemoveNamedPolicy(in.PType, in.Params)
 
-	out = &pb.BoolReply{Res: res}
+	out.Res = res
 	return err
 }
 
 // RemoveFilteredPolicy removes an authorization rule from the current policy, field filters can be specified.
 func (s *Server) RemoveFilteredPolicy(ctx context.Context, in *pb.FilteredPolicyRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.RemoveFilteredNamedPolicy("p", int(in.FieldIndex), in.FieldValues...)}
+	out.Res = e.RemoveFilteredNamedPolicy("p", int(in.FieldIndex), in.FieldValues...)
 	return nil
 }
 
 // RemoveFilteredNamedPolicy removes an authorization rule from the current named policy, field filters can be specified.
 func (s *Server) RemoveFilteredNamedPolicy(ctx context.Context, in *pb.FilteredPolicyRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.RemoveFilteredNamedPolicy(in.PType, int(in.FieldIndex), in.FieldValues...)}
+	out.Res = e.RemoveFilteredNamedPolicy(in.PType, int(in.FieldIndex), in.FieldValues...)
 	return nil
 }
 
@@ -274,55 +317,62 @@ func (s *Server) AddGroupingPolicy(ctx context.Context, in *pb.PolicyRequest, ou
 // If the rule already exists, the function returns false and the rule will not be added.
 // Otherwise the function returns true by adding the new rule.
 func (s *Server) AddNamedGroupingPolicy(ctx context.Context, in *pb.PolicyRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.AddNamedGroupingPolicy(in.PType, in.Params)}
+	if err := s.checkRoleLink(e.GetModel(), in.PType, in.Params); err != nil {
+		return err
+	}
+	if err := s.checkRules(ctx, e, 1); err != nil {
+		return err
+	}
+
+	out.Res = e.AddNamedGroupingPolicy(in.PType, in.Params)
 	return nil
 }
 
 // RemoveGroupingPolicy removes a role inheritance rule from the current policy.
 func (s *Server) RemoveGroupingPolicy(ctx context.Context, in *pb.PolicyRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.RemoveNamedGroupingPolicy("g", in.Params)}
+	out.Res = e.RemoveNamedGroupingPolicy("g", in.Params)
 	return nil
 }
 
 // RemoveNamedGroupingPolicy removes a role inheritance rule from the current named policy.
 func (s *Server) RemoveNamedGroupingPolicy(ctx context.Context, in *pb.PolicyRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.RemoveNamedGroupingPolicy(in.PType, in.Params)}
+	out.Res = e.RemoveNamedGroupingPolicy(in.PType, in.Params)
 	return nil
 }
 
 // RemoveFilteredGroupingPolicy removes a role inheritance rule from the current policy, field filters can be specified.
 func (s *Server) RemoveFilteredGroupingPolicy(ctx context.Context, in *pb.FilteredPolicyRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.RemoveFilteredNamedGroupingPolicy("g", int(in.FieldIndex), in.FieldValues...)}
+	out.Res = e.RemoveFilteredNamedGroupingPolicy("g", int(in.FieldIndex), in.FieldValues...)
 	return nil
 }
 
 // RemoveFilteredNamedGroupingPolicy removes a role inheritance rule from the current named policy, field filters can be specified.
 func (s *Server) RemoveFilteredNamedGroupingPolicy(ctx context.Context, in *pb.FilteredPolicyRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.RemoveFilteredNamedGroupingPolicy(in.PType, int(in.FieldIndex), in.FieldValues...)}
+	out.Res = e.RemoveFilteredNamedGroupingPolicy(in.PType, int(in.FieldIndex), in.FieldValues...)
 	return nil
 }
@@ -0,0 +1,61 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+//go:generate go run gen_model_templates.go -models ../models -out model_template_gen.go
+
+type modelTemplate struct {
+	name        string
+	description string
+}
+
+// modelTemplates is the catalogue of built-in models, in the order they are listed. The
+// text of a template is models/<name>_model.conf, see modelTexts.
+var modelTemplates = []modelTemplate{
+	{name: "acl", description: "Access control list: a subject may perform an action on an object."},
+	{name: "acl_with_superuser", description: "ACL where the subject \"root\" is allowed to do anything."},
+	{name: "basic_without_resources", description: "ACL without objects: a subject may perform an action."},
+	{name: "rbac", description: "Role-based access control, users inherit permissions through g rules."},
+	{name: "rbac_with_resource_roles", description: "RBAC where objects are grouped into resource roles through g2 rules."},
+	{name: "rbac_with_domains", description: "RBAC where roles are assigned per domain (tenant)."},
+	{name: "abac", description: "Attribute-based access control, the subject must own the object."},
+	{name: "restful", description: "RESTful paths matched with keyMatch2, HTTP methods with regexMatch and users with g roles."},
+	{name: "deny_override", description: "RBAC with explicit deny rules, any matching deny overrides all allows."},
+}
+
+func getModelTemplate(name string) (string, error) {
+	text, ok := modelTexts[name]
+	if !ok {
+		return "", invalidArgument("model template not found: %s", name)
+	}
+
+	return text, nil
+}
+
+// ListModelTemplates gets the built-in models that NewEnforcer accepts as modelTemplate.
+func (s *Server) ListModelTemplates(ctx context.Context, in *pb.EmptyRequest, out *pb.ModelTemplatesReply) error {
+	out.Templates = make([]*pb.ModelTemplate, 0, len(modelTemplates))
+	for _, t := range modelTemplates {
+		out.Templates = append(out.Templates, &pb.ModelTemplate{Name: t.name, Description: t.description, ModelText: modelTexts[t.name]})
+	}
+
+	return nil
+}
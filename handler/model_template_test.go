@@ -0,0 +1,45 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+func TestNewEnforcerModelTemplate(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(DataDir("../models"))
+	a := &pb.NewAdapterReply{}
+	if err := s.NewAdapter(ctx, &pb.NewAdapterRequest{AdapterName: "file", DriverName: "file", ConnectString: "rbac_policy.csv"}, a); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.NewEnforcer(ctx, &pb.NewEnforcerRequest{ModelTemplate: "rbac", AdapterHandle: a.Handler}, &pb.NewEnforcerReply{}); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := []*pb.NewEnforcerRequest{
+		{ModelTemplate: "rbca", AdapterHandle: a.Handler},
+		{ModelTemplate: "rbac", ModelText: "[request_definition]", AdapterHandle: a.Handler},
+	}
+	for _, in := range requests {
+		if err := s.NewEnforcer(ctx, in, &pb.NewEnforcerReply{}); !isInvalidArgument(err) {
+			t.Errorf("NewEnforcer(%q) error = %v, want %s", in.ModelTemplate, err, ErrorIDInvalidArgument)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"github.com/cicdi-go/casbin/secret"
+)
+
+// DefaultMaxRoleDepth is the default maximum length of a role inheritance chain,
+// the same limit the default role manager of casbin uses.
+const DefaultMaxRoleDepth = 10
+
+// DefaultSecretEnvPrefix is the prefix of the environment variables holding secrets by default.
+const DefaultSecretEnvPrefix = "CASBIN_SECRET_"
+
+// DefaultDataDir is the directory holding the files of the file and bolt adapters by default.
+const DefaultDataDir = "."
+
+// Options configures a Server.
+type Options struct {
+	// MaxRoleDepth is the maximum number of inheritance hops between a user and its
+	// furthest role. Grouping rules exceeding it are rejected, 0 means no limit.
+	MaxRoleDepth int
+	// SecretResolver resolves the ${secret:name} placeholders of adapter connection strings.
+	SecretResolver secret.Resolver
+	// Redactor masks the credentials of connection strings in returned errors.
+	Redactor *secret.Redactor
+	// DefaultQuota is the quota of the tenants missing from TenantQuotas.
+	DefaultQuota Quota
+	// TenantQuotas holds the quotas of specific tenants.
+	TenantQuotas map[string]Quota
+	// DataDir is the directory the file and bolt adapters are confined to.
+	DataDir string
+}
+
+// Option sets an option of a Server.
+type Option func(*Options)
+
+func newOptions(opts ...Option) Options {
+	options := Options{
+		MaxRoleDepth:   DefaultMaxRoleDepth,
+		SecretResolver: secret.Env(DefaultSecretEnvPrefix),
+		Redactor:       secret.NewRedactor(),
+		DataDir:        DefaultDataDir,
+	}
+
+	for _, o := range opts {
+		o(&options)
+	}
+
+	return options
+}
+
+// MaxRoleDepth sets the maximum length of role inheritance chains.
+func MaxRoleDepth(n int) Option {
+	return func(o *Options) {
+		o.MaxRoleDepth = n
+	}
+}
+
+// SecretResolver sets the resolver of the secrets referenced by connection strings.
+func SecretResolver(r secret.Resolver) Option {
+	return func(o *Options) {
+		o.SecretResolver = r
+	}
+}
+
+// Redactor sets the redactor masking credentials, to share it with RedactErrors.
+func Redactor(r *secret.Redactor) Option {
+	return func(o *Options) {
+		o.Redactor = r
+	}
+}
+
+// DefaultQuota sets the quota of the tenants without a quota of their own.
+func DefaultQuota(q Quota) Option {
+	return func(o *Options) {
+		o.DefaultQuota = q
+	}
+}
+
+// TenantQuotas sets the quotas of specific tenants.
+func TenantQuotas(quotas map[string]Quota) Option {
+	return func(o *Options) {
+		o.TenantQuotas = quotas
+	}
+}
+
+// DataDir sets the directory the file and bolt adapters are confined to.
+func DataDir(dir string) Option {
+	return func(o *Options) {
+		o.DataDir = dir
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+var errPageToken = invalidArgument("invalid page token")
+
+// paginate gets the range [start, end) of the page, and the token of the next page
+// which is empty on the last page. A page size of 0 returns everything.
+func paginate(total int, pageSize int32, pageToken string) (int, int, string, error) {
+	start := 0
+	if pageToken != "" {
+		var err error
+		start, err = strconv.Atoi(pageToken)
+		if err != nil || start < 0 || start > total {
+			return 0, 0, "", errPageToken
+		}
+	}
+
+	if pageSize <= 0 {
+		return start, total, "", nil
+	}
+
+	end := start + int(pageSize)
+	if end >= total {
+		return start, total, "", nil
+	}
+
+	return start, end, strconv.Itoa(end), nil
+}
+
+// pagePolicy searches, sorts and pages the rules as requested by page.
+// It returns the rules of the page, the token of the next page and the number of matching rules.
+func pagePolicy(policy [][]string, page *pb.PageOptions) ([][]string, string, int, error) {
+	match, err := searchMatcher(page)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	res := make([][]string, 0, len(policy))
+	for _, rule := range policy {
+		if match(rule) {
+			res = append(res, rule)
+		}
+	}
+
+	index := int(page.SortFieldIndex)
+	sort.SliceStable(res, func(i, j int) bool {
+		a, b := fieldValue(res[i], index), fieldValue(res[j], index)
+		if page.SortDescending {
+			return a > b
+		}
+		return a < b
+	})
+
+	start, end, next, err := paginate(len(res), page.PageSize, page.PageToken)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	return res[start:end], next, len(res), nil
+}
+
+func searchMatcher(page *pb.PageOptions) (func(rule []string) bool, error) {
+	if page.Search == "" {
+		return func(rule []string) bool { return true }, nil
+	}
+
+	var matchValue func(value string) bool
+	switch page.SearchMode {
+	case "", "substring":
+		matchValue = func(value string) bool { return strings.Contains(value, page.Search) }
+	case "prefix":
+		matchValue = func(value string) bool { return strings.HasPrefix(value, page.Search) }
+	case "regex":
+		re, err := regexp.Compile(page.Search)
+		if err != nil {
+			return nil, invalidArgument("invalid search regex: %v", err)
+		}
+		matchValue = re.MatchString
+	default:
+		return nil, invalidArgument("unsupported search mode: %s", page.SearchMode)
+	}
+	if page.SearchField < 0 {
+		return nil, invalidArgument("invalid search field: %d", page.SearchField)
+	}
+
+	// searchField is 1-based so that the zero value searches any field.
+	index := int(page.SearchField) - 1
+	return func(rule []string) bool {
+		if index >= 0 {
+			return index < len(rule) && matchValue(rule[index])
+		}
+		for _, value := range rule {
+			if matchValue(value) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func fieldValue(rule []string, index int) string {
+	if index < 0 || index >= len(rule) {
+		return ""
+	}
+	return rule[index]
+}
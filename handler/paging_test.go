@@ -0,0 +1,68 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+func TestPagePolicySearch(t *testing.T) {
+	policy := [][]string{
+		{"alice", "data1", "read"},
+		{"bob", "data2", "write"},
+		{"data2_admin", "data2", "read"},
+	}
+
+	tests := []struct {
+		name string
+		page pb.PageOptions
+		want [][]string
+	}{
+		{"no search", pb.PageOptions{}, policy},
+		{"any field by default", pb.PageOptions{Search: "data2"}, policy[1:]},
+		{"first field", pb.PageOptions{Search: "data2", SearchField: 1}, policy[2:]},
+		{"last field", pb.PageOptions{Search: "read", SearchField: 3}, [][]string{policy[0], policy[2]}},
+		{"field out of range", pb.PageOptions{Search: "read", SearchField: 4}, [][]string{}},
+		{"prefix", pb.PageOptions{Search: "data", SearchMode: "prefix", SearchField: 1}, policy[2:]},
+		{"regex", pb.PageOptions{Search: "^(alice|bob)$", SearchMode: "regex"}, policy[:2]},
+	}
+	for _, tt := range tests {
+		got, _, _, err := pagePolicy(policy, &tt.page)
+		if err != nil {
+			t.Errorf("%s: pagePolicy() error = %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: pagePolicy() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPagePolicyInvalid(t *testing.T) {
+	pages := []pb.PageOptions{
+		{Search: "x", SearchMode: "glob"},
+		{Search: "(", SearchMode: "regex"},
+		{Search: "x", SearchField: -1},
+		{PageToken: "x"},
+	}
+	for _, page := range pages {
+		if _, _, _, err := pagePolicy(nil, &page); !isInvalidArgument(err) {
+			t.Errorf("pagePolicy(%+v) error = %v, want %s", page, err, ErrorIDInvalidArgument)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/casbin/casbin/model"
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+	"gopkg.in/yaml.v2"
+)
+
+// policyLine is a rule of the policy as exported in the json and yaml formats.
+type policyLine struct {
+	PType string   `json:"ptype" yaml:"ptype"`
+	Rule  []string `json:"rule" yaml:"rule"`
+}
+
+// ExportPolicy serialises every p and g rule of the policy to the csv, json or yaml format.
+func (s *Server) ExportPolicy(ctx context.Context, in *pb.ExportPolicyRequest, out *pb.PolicyDataReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	var lines []policyLine
+	m := e.GetModel()
+	for _, sec := range []string{"p", "g"} {
+		for _, ptype := range sortedKeys(m[sec]) {
+			for _, rule := range m[sec][ptype].Policy {
+				lines = append(lines, policyLine{PType: ptype, Rule: rule})
+			}
+		}
+	}
+
+	data, err := marshalPolicy(lines, in.Format)
+	if err != nil {
+		return err
+	}
+
+	out.Format = in.Format
+	if out.Format == "" {
+		out.Format = "csv"
+	}
+	out.Data = string(data)
+	return nil
+}
+
+// ImportPolicy loads rules serialised by ExportPolicy, either merging them into the policy
+// or replacing it. Every rule is validated against the model before the policy is changed,
+// and the policy is saved if the enforcer has an adapter.
+func (s *Server) ImportPolicy(ctx context.Context, in *pb.ImportPolicyRequest, out *pb.ImportPolicyReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	if in.Mode != "" && in.Mode != "merge" && in.Mode != "replace" {
+		return invalidArgument("unsupported import mode: %s", in.Mode)
+	}
+	replace := in.Mode == "replace"
+	// The adapter would replace the whole stored policy with the filtered one.
+	if e.IsFiltered() {
+		return invalidArgument("cannot import into the filtered policy of enforcer %d", in.EnforcerHandler)
+	}
+
+	lines, err := unmarshalPolicy([]byte(in.Data), in.Format)
+	if err != nil {
+		return err
+	}
+
+	m := e.GetModel()
+	if err := s.validatePolicy(m, lines, replace); err != nil {
+		return err
+	}
+
+	if replace {
+		err = s.checkRuleCount(ctx, len(lines))
+	} else {
+		added := 0
+		for _, line := range lines {
+			if !m.HasPolicy(line.PType[:1], line.PType, line.Rule) {
+				added++
+			}
+		}
+		err = s.checkRules(ctx, e, added)
+	}
+	if err != nil {
+		return err
+	}
+
+	// The new policy is built and saved aside, the enforcer keeps its policy if the save fails.
+	policy := emptyPolicy(m)
+	if !replace {
+		for sec, assertions := range policy {
+			for ptype, ast := range assertions {
+				ast.Policy = append([][]string(nil), m[sec][ptype].Policy...)
+			}
+		}
+	}
+	for _, line := range lines {
+		sec := "g"
+		if _, ok := m["p"][line.PType]; ok {
+			sec = "p"
+		}
+
+		if policy.AddPolicy(sec, line.PType, line.Rule) {
+			out.Added++
+		} else {
+			out.Skipped++
+		}
+	}
+	if !replace && out.Added == 0 {
+		return nil
+	}
+
+	if a := e.GetAdapter(); a != nil {
+		if err := a.SavePolicy(policy); err != nil {
+			out.Added, out.Skipped = 0, 0
+			return err
+		}
+	}
+
+	for sec, assertions := range policy {
+		for ptype, ast := range assertions {
+			m[sec][ptype].Policy = ast.Policy
+		}
+	}
+	e.BuildRoleLinks()
+	return nil
+}
+
+// emptyPolicy copies the p and g assertions of m without their rules.
+func emptyPolicy(m model.Model) model.Model {
+	policy := model.Model{}
+	for _, sec := range []string{"p", "g"} {
+		policy[sec] = model.AssertionMap{}
+		for ptype, ast := range m[sec] {
+			cp := *ast
+			cp.Policy = nil
+			policy[sec][ptype] = &cp
+		}
+	}
+
+	return policy
+}
+
+// validatePolicy checks that every rule has a policy type of the model and the number of
+// fields its definition requires, and that the resulting role graphs stay consistent.
+func (s *Server) validatePolicy(m model.Model, lines []policyLine, replace bool) error {
+	grouping := map[string][][]string{}
+	if !replace {
+		for gtype, ast := range m["g"] {
+			grouping[gtype] = append(grouping[gtype], ast.Policy...)
+		}
+	}
+
+	for i, line := range lines {
+		var arity int
+		if ast, ok := m["p"][line.PType]; ok {
+			arity = len(ast.Tokens)
+		} else if ast, ok := m["g"][line.PType]; ok {
+			arity = strings.Count(ast.Value, "_")
+			grouping[line.PType] = append(grouping[line.PType], line.Rule)
+		} else {
+			return invalidArgument("rule %d: unknown policy type %s", i+1, line.PType)
+		}
+
+		if len(line.Rule) != arity {
+			return invalidArgument("rule %d: %s expects %d fields, got %d", i+1, line.PType, arity, len(line.Rule))
+		}
+	}
+
+	for gtype, policy := range grouping {
+		gm := model.Model{"g": model.AssertionMap{gtype: &model.Assertion{Key: gtype, Policy: policy}}}
+		// Roles only inherit within their domain, so each domain has a graph of its own.
+		for _, domain := range policyDomains(policy) {
+			if err := s.validateRoleGraph(newRoleGraph(gm, gtype, domain), gtype, domain); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// policyDomains gets the domains of the grouping rules, or "" for rules without domains.
+func policyDomains(policy [][]string) []string {
+	seen := map[string]bool{}
+	var domains []string
+	for _, rule := range policy {
+		domain := ""
+		if len(rule) > 2 {
+			domain = rule[2]
+		}
+		if !seen[domain] {
+			seen[domain] = true
+			domains = append(domains, domain)
+		}
+	}
+
+	return domains
+}
+
+// validateRoleGraph rejects inheritance cycles and chains deeper than MaxRoleDepth.
+func (s *Server) validateRoleGraph(g *roleGraph, gtype string, domain string) error {
+	if domain != "" {
+		gtype += " in " + domain
+	}
+
+	if cycles := g.cycles(); len(cycles) > 0 {
+		return invalidArgument("%s: the inheritance cycle %s is not allowed", gtype, strings.Join(cycles[0], " -> "))
+	}
+
+	if s.opts.MaxRoleDepth <= 0 {
+		return nil
+	}
+	for _, name := range g.names() {
+		if chain := g.longestChain(name, g.parents); len(chain)-1 > s.opts.MaxRoleDepth {
+			return invalidArgument("%s: the inheritance chain %s is longer than %d", gtype, strings.Join(chain, " -> "), s.opts.MaxRoleDepth)
+		}
+	}
+
+	return nil
+}
+
+func marshalPolicy(lines []policyLine, format string) ([]byte, error) {
+	switch format {
+	case "", "csv":
+		var buf bytes.Buffer
+		for _, line := range lines {
+			fields := append([]string{line.PType}, line.Rule...)
+			for i, field := range fields {
+				if strings.ContainsAny(field, ",\"\n") || strings.TrimSpace(field) != field {
+					fields[i] = `"` + strings.Replace(field, `"`, `""`, -1) + `"`
+				}
+			}
+			buf.WriteString(strings.Join(fields, ", "))
+			buf.WriteString("\n")
+		}
+		return buf.Bytes(), nil
+	case "json":
+		if lines == nil {
+			lines = []policyLine{}
+		}
+		return json.MarshalIndent(lines, "", "  ")
+	case "yaml":
+		return yaml.Marshal(lines)
+	default:
+		return nil, invalidArgument("unsupported policy format: %s", format)
+	}
+}
+
+func unmarshalPolicy(data []byte, format string) ([]policyLine, error) {
+	var lines []policyLine
+
+	switch format {
+	case "", "csv":
+		r := csv.NewReader(bytes.NewReader(data))
+		r.Comment = '#'
+		r.FieldsPerRecord = -1
+		r.TrimLeadingSpace = true
+
+		for {
+			record, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, invalidArgument("%v", err)
+			}
+			if len(record) < 2 {
+				return nil, invalidArgument("rule %d: a rule needs a policy type and at least one field", len(lines)+1)
+			}
+
+			lines = append(lines, policyLine{PType: record[0], Rule: record[1:]})
+		}
+	case "json":
+		if err := json.Unmarshal(data, &lines); err != nil {
+			return nil, invalidArgument("%v", err)
+		}
+	case "yaml":
+		if err := yaml.Unmarshal(data, &lines); err != nil {
+			return nil, invalidArgument("%v", err)
+		}
+	default:
+		return nil, invalidArgument("unsupported policy format: %s", format)
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/casbin/casbin"
+	"github.com/casbin/casbin/model"
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+	microerrors "github.com/micro/go-micro/errors"
+)
+
+func isInvalidArgument(err error) bool {
+	e, ok := err.(*microerrors.Error)
+	return ok && e.Code == 400 && e.Id == ErrorIDInvalidArgument
+}
+
+// domainModel is the model of rbac_with_domains_model.conf, built by hand so the
+// tests don't depend on the parser.
+func domainModel(grouping ...[]string) model.Model {
+	return model.Model{
+		"p": model.AssertionMap{"p": &model.Assertion{Key: "p", Value: "sub, dom, obj, act", Tokens: []string{"p_sub", "p_dom", "p_obj", "p_act"}}},
+		"g": model.AssertionMap{"g": &model.Assertion{Key: "g", Value: "_, _, _", Policy: grouping}},
+	}
+}
+
+func TestValidatePolicy(t *testing.T) {
+	s := NewServer(MaxRoleDepth(2))
+
+	tests := []struct {
+		name    string
+		m       model.Model
+		lines   []policyLine
+		replace bool
+		err     string
+	}{
+		{"valid", domainModel(), []policyLine{
+			{"p", []string{"admin", "domain1", "data1", "read"}},
+			{"g", []string{"alice", "admin", "domain1"}},
+		}, false, ""},
+		{"unknown type", domainModel(), []policyLine{{"p2", []string{"alice", "data1"}}}, false, "unknown policy type p2"},
+		{"arity", domainModel(), []policyLine{{"p", []string{"alice", "data1", "read"}}}, false, "p expects 4 fields, got 3"},
+		{"same names in two domains", domainModel(), []policyLine{
+			{"g", []string{"alice", "admin", "domain1"}},
+			{"g", []string{"admin", "alice", "domain2"}},
+		}, false, ""},
+		{"cycle in a domain", domainModel(), []policyLine{
+			{"g", []string{"alice", "admin", "domain1"}},
+			{"g", []string{"admin", "alice", "domain1"}},
+		}, false, "g in domain1: the inheritance cycle"},
+		{"cycle with the current policy", domainModel([]string{"alice", "admin", "domain1"}), []policyLine{
+			{"g", []string{"admin", "alice", "domain1"}},
+		}, false, "the inheritance cycle"},
+		{"replaced policy", domainModel([]string{"alice", "admin", "domain1"}), []policyLine{
+			{"g", []string{"admin", "alice", "domain1"}},
+		}, true, ""},
+		{"depth", domainModel(), []policyLine{
+			{"g", []string{"a", "b", "domain1"}},
+			{"g", []string{"b", "c", "domain1"}},
+			{"g", []string{"c", "d", "domain1"}},
+		}, false, "longer than 2"},
+	}
+
+	for _, tt := range tests {
+		err := s.validatePolicy(tt.m, tt.lines, tt.replace)
+		if tt.err == "" {
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+			}
+		} else if e, ok := err.(*microerrors.Error); !ok || e.Id != ErrorIDInvalidArgument || !strings.Contains(e.Detail, tt.err) {
+			t.Errorf("%s: got %v, want an invalid argument error %q", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestEmptyPolicy(t *testing.T) {
+	m := domainModel([]string{"alice", "admin", "domain1"})
+	m["p"]["p"].Policy = [][]string{{"admin", "domain1", "data1", "read"}}
+
+	policy := emptyPolicy(m)
+	for _, sec := range []string{"p", "g"} {
+		ast := policy[sec][sec]
+		if ast == nil || ast.Policy != nil || ast.Value != m[sec][sec].Value {
+			t.Errorf("%s: got %+v", sec, ast)
+		}
+	}
+	if len(m["g"]["g"].Policy) != 1 || len(m["p"]["p"].Policy) != 1 {
+		t.Errorf("the rules of the model were changed")
+	}
+}
+
+func TestMarshalPolicy(t *testing.T) {
+	lines := []policyLine{
+		{"p", []string{"alice", "data1", "read"}},
+		{"p", []string{"bob", "a, b", `say "hi"`}},
+		{"g", []string{"alice", "admin"}},
+	}
+
+	for _, format := range []string{"csv", "json", "yaml"} {
+		data, err := marshalPolicy(lines, format)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		got, err := unmarshalPolicy(data, format)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if !reflect.DeepEqual(got, lines) {
+			t.Errorf("%s: got %v, want %v", format, got, lines)
+		}
+	}
+
+	if _, err := marshalPolicy(lines, "xml"); !isInvalidArgument(err) {
+		t.Errorf("xml: got %v, want an invalid argument error", err)
+	}
+	for _, data := range []string{"p\n", `p, "alice`} {
+		if _, err := unmarshalPolicy([]byte(data), "csv"); !isInvalidArgument(err) {
+			t.Errorf("%q: got %v, want an invalid argument error", data, err)
+		}
+	}
+	if _, err := unmarshalPolicy([]byte("{"), "json"); !isInvalidArgument(err) {
+		t.Errorf("json: got %v, want an invalid argument error", err)
+	}
+}
+
+// failingAdapter loads an empty policy and fails to save it.
+type failingAdapter struct{}
+
+func (failingAdapter) LoadPolicy(m model.Model) error { return nil }
+func (failingAdapter) SavePolicy(m model.Model) error { return errors.New("disk full") }
+func (failingAdapter) AddPolicy(sec string, ptype string, rule []string) error {
+	return errors.New("disk full")
+}
+func (failingAdapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	return errors.New("disk full")
+}
+func (failingAdapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	return errors.New("disk full")
+}
+
+func TestImportPolicySaveError(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+
+	for _, mode := range []string{"merge", "replace"} {
+		e := casbin.NewEnforcer(domainModel(), failingAdapter{})
+		e.GetModel()["g"]["g"].Policy = [][]string{{"alice", "admin", "domain1"}}
+		e.BuildRoleLinks()
+
+		h, err := s.addEnforcer(ctx, e)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		in := &pb.ImportPolicyRequest{EnforcerHandler: int32(h), Mode: mode, Data: "p, admin, domain1, data1, read\ng, bob, admin, domain1\n"}
+		if err := s.ImportPolicy(ctx, in, &pb.ImportPolicyReply{}); err == nil {
+			t.Fatalf("%s: the save error was not returned", mode)
+		}
+
+		if policy := e.GetPolicy(); len(policy) != 0 {
+			t.Errorf("%s: got policy %v after the failed save", mode, policy)
+		}
+		if want := [][]string{{"alice", "admin", "domain1"}}; !reflect.DeepEqual(e.GetGroupingPolicy(), want) {
+			t.Errorf("%s: got grouping policy %v, want %v", mode, e.GetGroupingPolicy(), want)
+		}
+		if roles := e.GetRolesForUserInDomain("bob", "domain1"); len(roles) != 0 {
+			t.Errorf("%s: got roles %v of bob after the failed save", mode, roles)
+		}
+	}
+}
+
+func TestImportPolicyInvalid(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	h, err := s.addEnforcer(ctx, casbin.NewEnforcer(domainModel()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []*pb.ImportPolicyRequest{
+		{EnforcerHandler: int32(h), Mode: "upsert", Data: "p, admin, domain1, data1, read"},
+		{EnforcerHandler: int32(h), Format: "xml", Data: "p, admin, domain1, data1, read"},
+		{EnforcerHandler: int32(h), Data: "p2, admin, domain1, data1, read"},
+		{EnforcerHandler: int32(h), Data: "g, alice, admin, domain1\ng, admin, alice, domain1"},
+	}
+
+	for _, in := range tests {
+		if err := s.ImportPolicy(ctx, in, &pb.ImportPolicyReply{}); !isInvalidArgument(err) {
+			t.Errorf("%v: got %v, want an invalid argument error", in, err)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/casbin/casbin"
+	"github.com/casbin/casbin/model"
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+var errDomainRequired = invalidArgument("the model requires a domain")
+
+// requestValues builds the arguments of Enforce in the order of the request definition.
+func requestValues(m model.Model, sub string, domain string, obj string, act string) ([]interface{}, error) {
+	ast, ok := m["r"]["r"]
+	if !ok {
+		return nil, invalidArgument("request definition not found")
+	}
+
+	values := make([]interface{}, 0, len(ast.Tokens))
+	for _, token := range ast.Tokens {
+		switch strings.TrimPrefix(token, "r_") {
+		case "sub":
+			values = append(values, sub)
+		case "dom":
+			if domain == "" {
+				return nil, errDomainRequired
+			}
+			values = append(values, domain)
+		case "obj":
+			values = append(values, obj)
+		case "act":
+			values = append(values, act)
+		default:
+			return nil, invalidArgument("unsupported request field: %s", token)
+		}
+	}
+
+	return values, nil
+}
+
+// allSubjects gets every subject of the policy and every user or role of the subject role graph.
+func allSubjects(m model.Model) []string {
+	set := map[string]bool{}
+	for _, ast := range m["p"] {
+		for _, rule := range ast.Policy {
+			if len(rule) > 0 {
+				set[rule[0]] = true
+			}
+		}
+	}
+	for _, name := range newRoleGraph(m, subjectGroupingType(m, "p"), "").names() {
+		set[name] = true
+	}
+
+	res := make([]string, 0, len(set))
+	for name := range set {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+func enforceFor(e *casbin.Enforcer, sub string, domain string, obj string, act string) (bool, error) {
+	values, err := requestValues(e.GetModel(), sub, domain, obj, act)
+	if err != nil {
+		return false, err
+	}
+
+	return e.EnforceSafe(values...)
+}
+
+// WhoCan gets the subjects and roles that are allowed to perform an action on an object.
+// Every known subject is evaluated against the matcher, so pattern based rules are honoured.
+func (s *Server) WhoCan(ctx context.Context, in *pb.WhoCanRequest, out *pb.ArrayReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	out.Array = []string{}
+	for _, sub := range allSubjects(e.GetModel()) {
+		ok, err := enforceFor(e, sub, in.Domain, in.Obj, in.Act)
+		if err != nil {
+			return err
+		}
+		if ok {
+			out.Array = append(out.Array, sub)
+		}
+	}
+
+	return nil
+}
+
+// ListAllowed gets the (obj, act) pairs of the policy that the subject is allowed to perform,
+// following role inheritance. Objects can be narrowed down by prefix and the result is paged.
+func (s *Server) ListAllowed(ctx context.Context, in *pb.ListAllowedRequest, out *pb.Array2DReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	m := e.GetModel()
+	objIndex := policyFieldIndex(m, "p", "obj")
+	actIndex := policyFieldIndex(m, "p", "act")
+	if objIndex == -1 || actIndex == -1 {
+		return invalidArgument("the policy has no obj or act field")
+	}
+
+	resourceGraph := resourceRoleGraphs(m, "p", in.Domain)[objIndex]
+	candidates := map[[2]string]bool{}
+	for _, rule := range m["p"]["p"].Policy {
+		if len(rule) <= objIndex || len(rule) <= actIndex || !inDomain(m, "p", rule, in.Domain) {
+			continue
+		}
+
+		candidates[[2]string{rule[objIndex], rule[actIndex]}] = true
+		if resourceGraph != nil {
+			for _, object := range resourceGraph.descendants(rule[objIndex]) {
+				candidates[[2]string{object.name, rule[actIndex]}] = true
+			}
+		}
+	}
+
+	var allowed [][]string
+	for pair := range candidates {
+		if !strings.HasPrefix(pair[0], in.ObjectPrefix) {
+			continue
+		}
+
+		ok, err := enforceFor(e, in.Subject, in.Domain, pair[0], pair[1])
+		if err != nil {
+			return err
+		}
+		if ok {
+			allowed = append(allowed, []string{pair[0], pair[1]})
+		}
+	}
+
+	sort.Slice(allowed, func(i, j int) bool {
+		if allowed[i][0] != allowed[j][0] {
+			return allowed[i][0] < allowed[j][0]
+		}
+		return allowed[i][1] < allowed[j][1]
+	})
+
+	start, end, next, err := paginate(len(allowed), in.PageSize, in.PageToken)
+	if err != nil {
+		return err
+	}
+
+	*out = *s.wrapPlainPolicy(allowed[start:end])
+	out.NextPageToken = next
+	out.Total = int32(len(allowed))
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+func TestWhoCan(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(DataDir("../models"))
+	h := newFileEnforcer(t, s, "rbac_with_domains_model.conf", "rbac_with_domains_policy.csv")
+
+	out := &pb.ArrayReply{}
+	if err := s.WhoCan(ctx, &pb.WhoCanRequest{EnforcerHandler: h, Obj: "data1", Act: "read", Domain: "domain1"}, out); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(out.Array)
+	if want := []string{"admin", "alice"}; !reflect.DeepEqual(out.Array, want) {
+		t.Errorf("WhoCan() = %v, want %v", out.Array, want)
+	}
+
+	// The model has a domain, leaving it out is the caller's mistake.
+	err := s.WhoCan(ctx, &pb.WhoCanRequest{EnforcerHandler: h, Obj: "data1", Act: "read"}, &pb.ArrayReply{})
+	if !isInvalidArgument(err) {
+		t.Errorf("WhoCan() without domain error = %v, want %s", err, ErrorIDInvalidArgument)
+	}
+}
+
+func TestListAllowedPageToken(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(DataDir("../models"))
+	h := newFileEnforcer(t, s, "rbac_with_domains_model.conf", "rbac_with_domains_policy.csv")
+
+	for _, token := range []string{"x", "-1"} {
+		err := s.ListAllowed(ctx, &pb.ListAllowedRequest{EnforcerHandler: h, Subject: "alice", Domain: "domain1", PageToken: token}, &pb.Array2DReply{})
+		if !isInvalidArgument(err) {
+			t.Errorf("ListAllowed(pageToken %q) error = %v, want %s", token, err, ErrorIDInvalidArgument)
+		}
+	}
+}
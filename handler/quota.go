@@ -0,0 +1,106 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/casbin/casbin"
+	"github.com/cicdi-go/casbin/auth"
+	"github.com/cicdi-go/casbin/ratelimit"
+	microerrors "github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/server"
+	"gopkg.in/yaml.v2"
+)
+
+// StatusTooManyRequests is the error code of the calls rejected by a rate limit.
+const StatusTooManyRequests = ratelimit.StatusTooManyRequests
+
+// quotaExceeded gets the error of the calls over a quota of the tenant.
+func quotaExceeded(format string, a ...interface{}) error {
+	return microerrors.New(ErrorIDQuotaExceeded, "quota exceeded: "+fmt.Sprintf(format, a...), StatusTooManyRequests)
+}
+
+// Quota bounds what the callers of a tenant can use, 0 means no limit.
+type Quota struct {
+	// MaxEnforcers is the number of enforcers the tenant can create.
+	MaxEnforcers int `yaml:"maxEnforcers" json:"maxEnforcers"`
+	// MaxRules is the number of p and g rules an enforcer can hold, checked when rules
+	// are added through the API.
+	MaxRules int `yaml:"maxRules" json:"maxRules"`
+	// RequestsPerSecond is the sustained rate of RPCs of the tenant, Burst the number
+	// of RPCs allowed at once.
+	RequestsPerSecond float64 `yaml:"requestsPerSecond" json:"requestsPerSecond"`
+	Burst             int     `yaml:"burst" json:"burst"`
+}
+
+// quota gets the quota of a tenant.
+func (s *Server) quota(tenant string) Quota {
+	if q, ok := s.opts.TenantQuotas[tenant]; ok {
+		return q
+	}
+
+	return s.opts.DefaultQuota
+}
+
+// checkRules checks that n more rules fit in the rule quota of the enforcer.
+func (s *Server) checkRules(ctx context.Context, e *casbin.Enforcer, n int) error {
+	count := 0
+	for _, sec := range []string{"p", "g"} {
+		for _, ast := range e.GetModel()[sec] {
+			count += len(ast.Policy)
+		}
+	}
+
+	return s.checkRuleCount(ctx, count+n)
+}
+
+// checkRuleCount checks that an enforcer holding count rules fits in the rule quota.
+func (s *Server) checkRuleCount(ctx context.Context, count int) error {
+	q := s.quota(auth.TenantFromContext(ctx))
+	if q.MaxRules > 0 && count > q.MaxRules {
+		return quotaExceeded("at most %d rules per enforcer", q.MaxRules)
+	}
+	return nil
+}
+
+// LimitRequests is a handler wrapper enforcing the request rate quota of the tenant of
+// the caller, rejected calls fail with the StatusTooManyRequests code and, as they can be
+// retried, the ErrorIDRateLimited id. It must be wrapped by auth.Tenancy.
+func (s *Server) LimitRequests(fn server.HandlerFunc) server.HandlerFunc {
+	return func(ctx context.Context, req server.Request, rsp interface{}) error {
+		if t := s.getTenant(ctx); t.requests != nil && !t.requests.Allow() {
+			return microerrors.New(ErrorIDRateLimited, "request rate of tenant "+auth.TenantFromContext(ctx)+" exceeded", StatusTooManyRequests)
+		}
+
+		return fn(ctx, req, rsp)
+	}
+}
+
+// LoadTenantQuotas reads a YAML or JSON file mapping tenants to their quota.
+func LoadTenantQuotas(path string) (map[string]Quota, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	quotas := map[string]Quota{}
+	if err := yaml.Unmarshal(data, &quotas); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return quotas, nil
+}
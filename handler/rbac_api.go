@@ -21,33 +21,39 @@ import (
 
 // GetRolesForUser gets the roles that a user has.
 func (s *Server) GetRolesForUser(ctx context.Context, in *pb.UserRoleRequest, out *pb.ArrayReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	res, _ := e.GetModel()["g"]["g"].RM.GetRoles(in.User)
+	res, err := e.GetModel()["g"]["g"].RM.GetRoles(in.User)
+	if err != nil {
+		return err
+	}
 
-	out = &pb.ArrayReply{Array: res}
+	out.Array = res
 	return nil
 }
 
 // GetUsersForRole gets the users that has a role.
 func (s *Server) GetUsersForRole(ctx context.Context, in *pb.UserRoleRequest, out *pb.ArrayReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	res, _ := e.GetModel()["g"]["g"].RM.GetUsers(in.User)
+	res, err := e.GetModel()["g"]["g"].RM.GetUsers(in.Role)
+	if err != nil {
+		return err
+	}
 
-	out = &pb.ArrayReply{Array: res}
+	out.Array = res
 	return nil
 }
 
 // HasRoleForUser determines whether a user has a role.
 func (s *Server) HasRoleForUser(ctx context.Context, in *pb.UserRoleRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
@@ -56,66 +62,72 @@ func (s *Server) HasRoleForUser(ctx context.Context, in *pb.UserRoleRequest, out
 
 	for _, r := range roles {
 		if r == in.Role {
-			out = &pb.BoolReply{Res: true}
+			out.Res = true
 			return nil
 		}
 	}
 
-	out = &pb.BoolReply{}
 	return nil
 }
 
 // AddRoleForUser adds a role for a user.
 // Returns false if the user already has the role (aka not affected).
 func (s *Server) AddRoleForUser(ctx context.Context, in *pb.UserRoleRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.AddGroupingPolicy(in.User, in.Role)}
+	if err := s.checkRoleLink(e.GetModel(), "g", []string{in.User, in.Role}); err != nil {
+		return err
+	}
+	if err := s.checkRules(ctx, e, 1); err != nil {
+		return err
+	}
+
+	out.Res = e.AddGroupingPolicy(in.User, in.Role)
 	return nil
 }
 
 // DeleteRoleForUser deletes a role for a user.
 // Returns false if the user does not have the role (aka not affected).
 func (s *Server) DeleteRoleForUser(ctx context.Context, in *pb.UserRoleRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.RemoveGroupingPolicy(in.User, in.Role)}
+	out.Res = e.RemoveGroupingPolicy(in.User, in.Role)
 	return nil
 }
 
 // DeleteRolesForUser deletes all roles for a user.
 // Returns false if the user does not have any roles (aka not affected).
 func (s *Server) DeleteRolesForUser(ctx context.Context, in *pb.UserRoleRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.RemoveFilteredGroupingPolicy(0, in.User)}
+	out.Res = e.RemoveFilteredGroupingPolicy(0, in.User)
 	return nil
 }
 
 // DeleteUser deletes a user.
 // Returns false if the user does not exist (aka not affected).
 func (s *Server) DeleteUser(ctx context.Context, in *pb.UserRoleRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.RemoveFilteredGroupingPolicy(0, in.User)}
+	out.Res = e.RemoveFilteredGroupingPolicy(0, in.User)
 	return nil
 }
 
 // DeleteRole deletes a role.
 func (s *Server) DeleteRole(ctx context.Context, in *pb.UserRoleRequest, out *pb.EmptyReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
@@ -123,77 +135,80 @@ func (s *Server) DeleteRole(ctx context.Context, in *pb.UserRoleRequest, out *pb
 	e.RemoveFilteredGroupingPolicy(1, in.Role)
 	e.RemoveFilteredPolicy(0, in.Role)
 
-	out = &pb.EmptyReply{}
 	return nil
 }
 
 // DeletePermission deletes a permission.
 // Returns false if the permission does not exist (aka not affected).
 func (s *Server) DeletePermission(ctx context.Context, in *pb.PermissionRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.RemoveFilteredPolicy(1, in.Permissions...)}
+	out.Res = e.RemoveFilteredPolicy(1, in.Permissions...)
 	return nil
 }
 
 // AddPermissionForUser adds a permission for a user or role.
 // Returns false if the user or role already has the permission (aka not affected).
 func (s *Server) AddPermissionForUser(ctx context.Context, in *pb.PermissionRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.AddPolicy(s.convertPermissions(in.User, in.Permissions...)...)}
+	if err := s.checkRules(ctx, e, 1); err != nil {
+		return err
+	}
+
+	out.Res = e.AddPolicy(s.convertPermissions(in.User, in.Permissions...)...)
 	return nil
 }
 
 // DeletePermissionForUser deletes a permission for a user or role.
 // Returns false if the user or role does not have the permission (aka not affected).
 func (s *Server) DeletePermissionForUser(ctx context.Context, in *pb.PermissionRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.RemovePolicy(s.convertPermissions(in.User, in.Permissions...)...)}
+	out.Res = e.RemovePolicy(s.convertPermissions(in.User, in.Permissions...)...)
 	return nil
 }
 
 // DeletePermissionsForUser deletes permissions for a user or role.
 // Returns false if the user or role does not have any permissions (aka not affected).
 func (s *Server) DeletePermissionsForUser(ctx context.Context, in *pb.PermissionRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.RemoveFilteredPolicy(0, in.User)}
+	out.Res = e.RemoveFilteredPolicy(0, in.User)
 	return nil
 }
 
 // GetPermissionsForUser gets permissions for a user or role.
 func (s *Server) GetPermissionsForUser(ctx context.Context, in *pb.PermissionRequest, out *pb.Array2DReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = s.wrapPlainPolicy(e.GetFilteredPolicy(0, in.User))
+	*out = *s.wrapPlainPolicy(e.GetFilteredPolicy(0, in.User))
 	return nil
 }
 
 // HasPermissionForUser determines whether a user has a permission.
 func (s *Server) HasPermissionForUser(ctx context.Context, in *pb.PermissionRequest, out *pb.BoolReply) error {
-	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
 	if err != nil {
 		return err
 	}
 
-	out = &pb.BoolReply{Res: e.HasPolicy(s.convertPermissions(in.User, in.Permissions...)...)}
+	out.Res = e.HasPolicy(s.convertPermissions(in.User, in.Permissions...)...)
 	return nil
 }
 
@@ -0,0 +1,211 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/casbin/casbin/model"
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+var errPType = invalidArgument("policy type not found")
+
+// GetImplicitRolesForUser gets the roles that a user has directly or through role inheritance.
+// The grouping type can be given as pType to walk other role graphs, e.g. g2 for resource roles.
+func (s *Server) GetImplicitRolesForUser(ctx context.Context, in *pb.UserRoleRequest, out *pb.InheritedReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	gtype := in.PType
+	if gtype == "" {
+		gtype = "g"
+	}
+	if _, ok := e.GetModel()["g"][gtype]; !ok {
+		return errPType
+	}
+
+	for _, role := range newRoleGraph(e.GetModel(), gtype, in.Domain).ancestors(in.User) {
+		out.Items = append(out.Items, &pb.InheritedRule{Rule: []string{role.name}, Path: role.path})
+	}
+
+	return nil
+}
+
+// GetImplicitPermissionsForUser gets the permissions of a user, including the ones inherited
+// from its roles. Rules on resource roles are also expanded to the objects that inherit them.
+func (s *Server) GetImplicitPermissionsForUser(ctx context.Context, in *pb.PermissionRequest, out *pb.InheritedReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	m := e.GetModel()
+	ptype := in.PType
+	if ptype == "" {
+		ptype = "p"
+	}
+	if _, ok := m["p"][ptype]; !ok {
+		return errPType
+	}
+
+	subjectGraph := newRoleGraph(m, subjectGroupingType(m, ptype), in.Domain)
+	subjects := append([]inheritance{{name: in.User, path: []string{in.User}}}, subjectGraph.ancestors(in.User)...)
+	resourceGraphs := resourceRoleGraphs(m, ptype, in.Domain)
+
+	seen := map[string]bool{}
+	add := func(rule []string, path []string, objectPath []string) {
+		key := strings.Join(rule, ", ")
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		out.Items = append(out.Items, &pb.InheritedRule{Rule: rule, Path: path, ObjectPath: objectPath})
+	}
+
+	for _, subject := range subjects {
+		for _, rule := range m["p"][ptype].Policy {
+			if rule[0] != subject.name || !inDomain(m, ptype, rule, in.Domain) {
+				continue
+			}
+
+			add(rule, subject.path, nil)
+			for index, graph := range resourceGraphs {
+				for _, object := range graph.descendants(rule[index]) {
+					expanded := append([]string(nil), rule...)
+					expanded[index] = object.name
+					add(expanded, subject.path, object.path)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetImplicitUsersForPermission gets the users that have a permission, directly or through
+// role inheritance. The permission is the rule without its subject, e.g. [data1, read].
+func (s *Server) GetImplicitUsersForPermission(ctx context.Context, in *pb.PermissionRequest, out *pb.InheritedReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	m := e.GetModel()
+	ptype := in.PType
+	if ptype == "" {
+		ptype = "p"
+	}
+	if _, ok := m["p"][ptype]; !ok {
+		return errPType
+	}
+
+	subjectGraph := newRoleGraph(m, subjectGroupingType(m, ptype), in.Domain)
+	resourceGraphs := resourceRoleGraphs(m, ptype, in.Domain)
+
+	users := map[string]*pb.InheritedRule{}
+	for _, rule := range m["p"][ptype].Policy {
+		if len(rule) != len(in.Permissions)+1 || !inDomain(m, ptype, rule, in.Domain) {
+			continue
+		}
+
+		objectPath, ok := matchPermission(rule, in.Permissions, resourceGraphs)
+		if !ok {
+			continue
+		}
+
+		subjects := append([]inheritance{{name: rule[0], path: []string{rule[0]}}}, subjectGraph.descendants(rule[0])...)
+		for _, subject := range subjects {
+			if subjectGraph.isRole(subject.name) {
+				continue
+			}
+			if _, ok := users[subject.name]; !ok {
+				users[subject.name] = &pb.InheritedRule{Rule: rule, Path: subject.path, ObjectPath: objectPath}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(users))
+	for name := range users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		out.Items = append(out.Items, users[name])
+	}
+
+	return nil
+}
+
+// resourceRoleGraphs gets the role graphs applied to policy fields other than the subject,
+// keyed by the index of the field.
+func resourceRoleGraphs(m model.Model, ptype string, domain string) map[int]*roleGraph {
+	res := map[int]*roleGraph{}
+	for gtype, index := range groupingFields(m, ptype) {
+		if index != 0 {
+			res[index] = newRoleGraph(m, gtype, domain)
+		}
+	}
+
+	return res
+}
+
+// inDomain determines whether a rule belongs to domain. Rules always match when
+// no domain is given or the policy has no dom field.
+func inDomain(m model.Model, ptype string, rule []string, domain string) bool {
+	if domain == "" {
+		return true
+	}
+
+	index := policyFieldIndex(m, ptype, "dom")
+	return index == -1 || index >= len(rule) || rule[index] == domain
+}
+
+// matchPermission determines whether rule grants the permission. A field grouped by a
+// resource role matches the objects inheriting it, their path is returned.
+func matchPermission(rule []string, permission []string, resourceGraphs map[int]*roleGraph) ([]string, bool) {
+	var objectPath []string
+
+	for i, value := range permission {
+		index := i + 1
+		if rule[index] == value {
+			continue
+		}
+
+		graph, ok := resourceGraphs[index]
+		if !ok {
+			return nil, false
+		}
+
+		found := false
+		for _, object := range graph.descendants(rule[index]) {
+			if object.name == value {
+				objectPath = object.path
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+
+	return objectPath, true
+}
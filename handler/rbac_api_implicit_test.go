@@ -0,0 +1,42 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+func TestGetImplicitRolesForUser(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(DataDir("../models"))
+	h := newFileEnforcer(t, s, "rbac_model.conf", "rbac_policy.csv")
+
+	out := &pb.InheritedReply{}
+	if err := s.GetImplicitRolesForUser(ctx, &pb.UserRoleRequest{EnforcerHandler: h, User: "alice"}, out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Items) != 1 || !reflect.DeepEqual(out.Items[0].Rule, []string{"data2_admin"}) {
+		t.Errorf("got %v, want data2_admin", out.Items)
+	}
+
+	err := s.GetImplicitRolesForUser(ctx, &pb.UserRoleRequest{EnforcerHandler: h, User: "alice", PType: "g9"}, &pb.InheritedReply{})
+	if !isInvalidArgument(err) {
+		t.Errorf("g9: got %v, want an invalid argument error", err)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"sort"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+// GetRolesForUserInDomain gets the roles that a user has inside a domain.
+func (s *Server) GetRolesForUserInDomain(ctx context.Context, in *pb.UserRoleRequest, out *pb.ArrayReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	res, err := e.GetModel()["g"]["g"].RM.GetRoles(in.User, in.Domain)
+	if err != nil {
+		return err
+	}
+
+	out.Array = res
+	return nil
+}
+
+// GetUsersForRoleInDomain gets the users that has a role inside a domain.
+func (s *Server) GetUsersForRoleInDomain(ctx context.Context, in *pb.UserRoleRequest, out *pb.ArrayReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	res, err := e.GetModel()["g"]["g"].RM.GetUsers(in.Role, in.Domain)
+	if err != nil {
+		return err
+	}
+
+	out.Array = res
+	return nil
+}
+
+// AddRoleForUserInDomain adds a role for a user inside a domain.
+// Returns false if the user already has the role (aka not affected).
+func (s *Server) AddRoleForUserInDomain(ctx context.Context, in *pb.UserRoleRequest, out *pb.BoolReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkRoleLink(e.GetModel(), "g", []string{in.User, in.Role, in.Domain}); err != nil {
+		return err
+	}
+	if err := s.checkRules(ctx, e, 1); err != nil {
+		return err
+	}
+
+	out.Res = e.AddGroupingPolicy(in.User, in.Role, in.Domain)
+	return nil
+}
+
+// DeleteRoleForUserInDomain deletes a role for a user inside a domain.
+// Returns false if the user does not have the role (aka not affected).
+func (s *Server) DeleteRoleForUserInDomain(ctx context.Context, in *pb.UserRoleRequest, out *pb.BoolReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	out.Res = e.RemoveGroupingPolicy(in.User, in.Role, in.Domain)
+	return nil
+}
+
+// GetPermissionsForUserInDomain gets permissions for a user or role inside a domain.
+func (s *Server) GetPermissionsForUserInDomain(ctx context.Context, in *pb.PermissionRequest, out *pb.Array2DReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	*out = *s.wrapPlainPolicy(e.GetFilteredPolicy(0, in.User, in.Domain))
+	return nil
+}
+
+// GetAllDomains gets the list of domains that show up in the role inheritance rules.
+func (s *Server) GetAllDomains(ctx context.Context, in *pb.EmptyRequest, out *pb.ArrayReply) error {
+	e, err := s.getEnforcer(ctx, int(in.Handler))
+	if err != nil {
+		return err
+	}
+
+	domains := map[string]bool{}
+	for _, ast := range e.GetModel()["g"] {
+		for _, rule := range ast.Policy {
+			if len(rule) > 2 {
+				domains[rule[2]] = true
+			}
+		}
+	}
+
+	out.Array = make([]string, 0, len(domains))
+	for domain := range domains {
+		out.Array = append(out.Array, domain)
+	}
+	sort.Strings(out.Array)
+
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"testing"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+// newFileEnforcer creates an enforcer of the model and policy files in ../models.
+func newFileEnforcer(t *testing.T, s *Server, model string, policy string) int32 {
+	text, err := ioutil.ReadFile("../models/" + model)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	a := &pb.NewAdapterReply{}
+	if err := s.NewAdapter(ctx, &pb.NewAdapterRequest{AdapterName: "file", DriverName: "file", ConnectString: policy}, a); err != nil {
+		t.Fatal(err)
+	}
+	e := &pb.NewEnforcerReply{}
+	if err := s.NewEnforcer(ctx, &pb.NewEnforcerRequest{ModelText: string(text), AdapterHandle: a.Handler}, e); err != nil {
+		t.Fatal(err)
+	}
+	if e.LoadError != "" {
+		t.Fatal(e.LoadError)
+	}
+
+	return e.Handler
+}
+
+func TestRolesInDomains(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(DataDir("../models"))
+	h := newFileEnforcer(t, s, "rbac_with_domains_model.conf", "rbac_with_domains_policy.csv")
+
+	// carol is an admin of domain1 only, while bob keeps the admin role of domain2.
+	if err := s.AddRoleForUserInDomain(ctx, &pb.UserRoleRequest{EnforcerHandler: h, User: "carol", Role: "admin", Domain: "domain1"}, &pb.BoolReply{}); err != nil {
+		t.Fatal(err)
+	}
+
+	roles := []struct {
+		user   string
+		domain string
+		want   []string
+	}{
+		{"alice", "domain1", []string{"admin"}},
+		{"alice", "domain2", []string{"reader"}},
+		{"bob", "domain1", nil},
+		{"bob", "domain2", []string{"admin"}},
+		{"carol", "domain1", []string{"admin"}},
+	}
+	for _, tt := range roles {
+		out := &pb.ArrayReply{}
+		if err := s.GetRolesForUserInDomain(ctx, &pb.UserRoleRequest{EnforcerHandler: h, User: tt.user, Domain: tt.domain}, out); err != nil {
+			t.Fatal(err)
+		}
+		if (len(out.Array) != 0 || len(tt.want) != 0) && !reflect.DeepEqual(out.Array, tt.want) {
+			t.Errorf("roles of %s in %s: got %v, want %v", tt.user, tt.domain, out.Array, tt.want)
+		}
+	}
+
+	users := []struct {
+		role   string
+		domain string
+		want   []string
+	}{
+		{"admin", "domain1", []string{"alice", "carol"}},
+		{"admin", "domain2", []string{"bob"}},
+		{"reader", "domain2", []string{"alice"}},
+	}
+	for _, tt := range users {
+		out := &pb.ArrayReply{}
+		if err := s.GetUsersForRoleInDomain(ctx, &pb.UserRoleRequest{EnforcerHandler: h, Role: tt.role, Domain: tt.domain}, out); err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(out.Array)
+		if !reflect.DeepEqual(out.Array, tt.want) {
+			t.Errorf("users of %s in %s: got %v, want %v", tt.role, tt.domain, out.Array, tt.want)
+		}
+	}
+
+	enforce := []struct {
+		params []string
+		want   bool
+	}{
+		{[]string{"alice", "domain1", "data1", "write"}, true},
+		{[]string{"alice", "domain2", "data2", "read"}, true},
+		{[]string{"alice", "domain2", "data2", "write"}, false},
+		{[]string{"alice", "domain1", "data2", "read"}, false},
+		{[]string{"bob", "domain2", "data2", "write"}, true},
+		{[]string{"bob", "domain1", "data1", "read"}, false},
+		{[]string{"carol", "domain1", "data1", "read"}, true},
+		{[]string{"carol", "domain2", "data2", "read"}, false},
+	}
+	for _, tt := range enforce {
+		out := &pb.BoolReply{}
+		if err := s.Enforce(ctx, &pb.EnforceRequest{EnforcerHandler: h, Params: tt.params}, out); err != nil {
+			t.Fatal(err)
+		}
+		if out.Res != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.params, out.Res, tt.want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cicdi-go/casbin/secret"
+	microerrors "github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/server"
+)
+
+// redact masks the credentials found in the message of err.
+func (s *Server) redact(err error) error {
+	return redactError(s.opts.Redactor, err)
+}
+
+func redactError(r *secret.Redactor, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if e, ok := err.(*microerrors.Error); ok {
+		res := *e
+		res.Detail = r.Redact(e.Detail)
+		return &res
+	}
+
+	msg := err.Error()
+	if redacted := r.Redact(msg); redacted != msg {
+		return errors.New(redacted)
+	}
+	return err
+}
+
+// RedactErrors is a handler wrapper masking the credentials found in the errors returned
+// by the handlers. It should share its Redactor with the Server.
+func RedactErrors(r *secret.Redactor) server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			return redactError(r, fn(ctx, req, rsp))
+		}
+	}
+}
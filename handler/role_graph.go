@@ -0,0 +1,274 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/casbin/casbin/model"
+)
+
+// roleGraph is the role inheritance graph built from the rules of one grouping type.
+type roleGraph struct {
+	parents  map[string][]string
+	children map[string][]string
+}
+
+// inheritance is a name reached through the role graph, path lists every hop starting from the queried name.
+type inheritance struct {
+	name string
+	path []string
+}
+
+// newRoleGraph builds the graph of the grouping type ptype. If domain is not empty,
+// only the rules of that domain are used.
+func newRoleGraph(m model.Model, ptype string, domain string) *roleGraph {
+	g := &roleGraph{parents: map[string][]string{}, children: map[string][]string{}}
+
+	ast, ok := m["g"][ptype]
+	if !ok {
+		return g
+	}
+
+	for _, rule := range ast.Policy {
+		if len(rule) < 2 {
+			continue
+		}
+		if domain != "" && len(rule) > 2 && rule[2] != domain {
+			continue
+		}
+		g.addEdge(rule[0], rule[1])
+	}
+
+	for name := range g.parents {
+		sort.Strings(g.parents[name])
+	}
+	for name := range g.children {
+		sort.Strings(g.children[name])
+	}
+
+	return g
+}
+
+func (g *roleGraph) addEdge(user string, role string) {
+	for _, r := range g.parents[user] {
+		if r == role {
+			return
+		}
+	}
+
+	g.parents[user] = append(g.parents[user], role)
+	g.children[role] = append(g.children[role], user)
+}
+
+// isRole determines whether name is inherited by anyone.
+func (g *roleGraph) isRole(name string) bool {
+	return len(g.children[name]) > 0
+}
+
+// names gets every user and role of the graph, sorted.
+func (g *roleGraph) names() []string {
+	set := map[string]bool{}
+	for name := range g.parents {
+		set[name] = true
+	}
+	for name := range g.children {
+		set[name] = true
+	}
+
+	res := make([]string, 0, len(set))
+	for name := range set {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// ancestors gets the roles that name inherits directly or indirectly, each with the
+// shortest path from name to the role.
+func (g *roleGraph) ancestors(name string) []inheritance {
+	return g.walk(name, g.parents, false)
+}
+
+// descendants gets the users and roles that inherit name directly or indirectly, each
+// with the shortest path from the descendant to name.
+func (g *roleGraph) descendants(name string) []inheritance {
+	return g.walk(name, g.children, true)
+}
+
+func (g *roleGraph) walk(name string, edges map[string][]string, reverse bool) []inheritance {
+	var res []inheritance
+	visited := map[string]bool{name: true}
+	queue := []inheritance{{name: name, path: []string{name}}}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		for _, next := range edges[cur.name] {
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+
+			path := make([]string, 0, len(cur.path)+1)
+			if reverse {
+				path = append(append(path, next), cur.path...)
+			} else {
+				path = append(append(path, cur.path...), next)
+			}
+
+			queue = append(queue, inheritance{name: next, path: path})
+			res = append(res, inheritance{name: next, path: path})
+		}
+	}
+
+	return res
+}
+
+// cycles gets every inheritance cycle of the graph, each starting and ending with the same name.
+func (g *roleGraph) cycles() [][]string {
+	var res [][]string
+	seen := map[string]bool{}
+	state := map[string]int{}
+	var stack []string
+
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = 1
+		stack = append(stack, name)
+
+		for _, role := range g.parents[name] {
+			switch state[role] {
+			case 0:
+				visit(role)
+			case 1:
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == role {
+						cycle := append(append([]string(nil), stack[i:]...), role)
+						if key := cycleKey(cycle); !seen[key] {
+							seen[key] = true
+							res = append(res, cycle)
+						}
+						break
+					}
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[name] = 2
+	}
+
+	for _, name := range g.names() {
+		if state[name] == 0 {
+			visit(name)
+		}
+	}
+
+	return res
+}
+
+// cycleKey identifies a cycle regardless of the name it starts with.
+func cycleKey(cycle []string) string {
+	names := append([]string(nil), cycle[:len(cycle)-1]...)
+	sort.Strings(names)
+	return strings.Join(names, "\x00")
+}
+
+// longestChain gets the longest inheritance chain starting from name, following parents
+// or children. Names on a cycle end the chain.
+func (g *roleGraph) longestChain(name string, edges map[string][]string) []string {
+	memo := map[string][]string{}
+	visiting := map[string]bool{}
+
+	var chain func(name string) []string
+	chain = func(name string) []string {
+		if res, ok := memo[name]; ok {
+			return res
+		}
+		if visiting[name] {
+			return nil
+		}
+		visiting[name] = true
+
+		var longest []string
+		for _, next := range edges[name] {
+			if c := chain(next); len(c) > len(longest) {
+				longest = c
+			}
+		}
+
+		visiting[name] = false
+		res := append([]string{name}, longest...)
+		memo[name] = res
+		return res
+	}
+
+	return chain(name)
+}
+
+var groupingCallRegex = regexp.MustCompile(`\b(g\d*)\s*\(\s*r[._](\w+)\s*,\s*p[._](\w+)`)
+
+// groupingFields maps every grouping type used by the matcher to the index of the
+// policy field it is applied to, e.g. g2(r.obj, p.obj) maps g2 to the index of obj.
+func groupingFields(m model.Model, ptype string) map[string]int {
+	res := map[string]int{}
+
+	if m["m"]["m"] == nil || m["p"][ptype] == nil {
+		return res
+	}
+
+	for _, match := range groupingCallRegex.FindAllStringSubmatch(m["m"]["m"].Value, -1) {
+		if _, ok := m["g"][match[1]]; !ok {
+			continue
+		}
+		if index := policyFieldIndex(m, ptype, match[3]); index != -1 {
+			res[match[1]] = index
+		}
+	}
+
+	return res
+}
+
+// subjectGroupingType gets the grouping type applied to the subject of the policy, "g" by default.
+func subjectGroupingType(m model.Model, ptype string) string {
+	for gtype, index := range groupingFields(m, ptype) {
+		if index == 0 {
+			return gtype
+		}
+	}
+
+	return "g"
+}
+
+// policyFieldIndex gets the index of a field of the policy definition, or -1 if the field is not defined.
+func policyFieldIndex(m model.Model, ptype string, field string) int {
+	ast, ok := m["p"][ptype]
+	if !ok {
+		return -1
+	}
+
+	for i, token := range ast.Tokens {
+		if token == ptype+"_"+field {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,213 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/casbin/casbin/model"
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+type roleGraphNode struct {
+	ID          string     `json:"id"`
+	Type        string     `json:"type"`
+	Permissions [][]string `json:"permissions,omitempty"`
+}
+
+type roleGraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type roleGraphDocument struct {
+	Nodes []roleGraphNode `json:"nodes"`
+	Edges []roleGraphEdge `json:"edges"`
+}
+
+// ExportRoleGraph exports the role inheritance graph of a grouping type as JSON or Graphviz DOT.
+func (s *Server) ExportRoleGraph(ctx context.Context, in *pb.RoleGraphRequest, out *pb.RoleGraphReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	m := e.GetModel()
+	gtype := in.PType
+	if gtype == "" {
+		gtype = "g"
+	}
+	if _, ok := m["g"][gtype]; !ok {
+		return errPType
+	}
+
+	doc := buildRoleGraphDocument(m, newRoleGraph(m, gtype, in.Domain), in.Domain, in.IncludePermissions)
+
+	out.Format = in.Format
+	switch in.Format {
+	case "", "json":
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		out.Format = "json"
+		out.Data = string(data)
+	case "dot":
+		out.Data = roleGraphToDot(gtype, doc)
+	default:
+		return invalidArgument("unsupported role graph format: %s", in.Format)
+	}
+
+	return nil
+}
+
+func buildRoleGraphDocument(m model.Model, g *roleGraph, domain string, includePermissions bool) roleGraphDocument {
+	doc := roleGraphDocument{Nodes: []roleGraphNode{}, Edges: []roleGraphEdge{}}
+
+	for _, name := range g.names() {
+		node := roleGraphNode{ID: name, Type: "user"}
+		if g.isRole(name) {
+			node.Type = "role"
+		}
+		if includePermissions && m["p"]["p"] != nil {
+			for _, rule := range m["p"]["p"].Policy {
+				if rule[0] == name && inDomain(m, "p", rule, domain) {
+					node.Permissions = append(node.Permissions, rule[1:])
+				}
+			}
+		}
+		doc.Nodes = append(doc.Nodes, node)
+
+		for _, role := range g.parents[name] {
+			doc.Edges = append(doc.Edges, roleGraphEdge{From: name, To: role})
+		}
+	}
+
+	return doc
+}
+
+func roleGraphToDot(name string, doc roleGraphDocument) string {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "digraph %s {\n", strconv.Quote(name))
+	for _, node := range doc.Nodes {
+		label := node.ID
+		for _, permission := range node.Permissions {
+			label += "\n" + strings.Join(permission, ", ")
+		}
+
+		shape := "ellipse"
+		if node.Type == "role" {
+			shape = "box"
+		}
+		fmt.Fprintf(&buf, "  %s [shape=%s, label=%s];\n", strconv.Quote(node.ID), shape, strconv.Quote(label))
+	}
+	for _, edge := range doc.Edges {
+		fmt.Fprintf(&buf, "  %s -> %s;\n", strconv.Quote(edge.From), strconv.Quote(edge.To))
+	}
+	buf.WriteString("}\n")
+
+	return buf.String()
+}
+
+// CheckRoleGraph reports the inheritance cycles, the roles granting nothing and the
+// inheritance chains longer than the configured maximum depth of a role graph.
+func (s *Server) CheckRoleGraph(ctx context.Context, in *pb.RoleGraphRequest, out *pb.CheckRoleGraphReply) error {
+	e, err := s.getEnforcer(ctx, int(in.EnforcerHandler))
+	if err != nil {
+		return err
+	}
+
+	m := e.GetModel()
+	gtype := in.PType
+	if gtype == "" {
+		gtype = "g"
+	}
+	if _, ok := m["g"][gtype]; !ok {
+		return errPType
+	}
+
+	g := newRoleGraph(m, gtype, in.Domain)
+	out.MaxDepth = int32(s.opts.MaxRoleDepth)
+
+	for _, cycle := range g.cycles() {
+		out.Cycles = append(out.Cycles, &pb.RolePath{Path: cycle})
+	}
+
+	for _, name := range g.names() {
+		if g.isRole(name) && len(g.parents[name]) == 0 && !hasPermissions(m, name, in.Domain) {
+			out.OrphanRoles = append(out.OrphanRoles, name)
+		}
+
+		if s.opts.MaxRoleDepth > 0 && !g.isRole(name) {
+			if chain := g.longestChain(name, g.parents); len(chain)-1 > s.opts.MaxRoleDepth {
+				out.DeepChains = append(out.DeepChains, &pb.RolePath{Path: chain})
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkRoleLink validates a grouping rule before it is added: the rule must not create an
+// inheritance cycle, nor a chain longer than the maximum depth.
+func (s *Server) checkRoleLink(m model.Model, gtype string, rule []string) error {
+	if len(rule) < 2 {
+		return nil
+	}
+
+	user, role, domain := rule[0], rule[1], ""
+	if len(rule) > 2 {
+		domain = rule[2]
+	}
+
+	if user == role {
+		return invalidArgument("%s cannot inherit itself", user)
+	}
+
+	g := newRoleGraph(m, gtype, domain)
+	for _, ancestor := range g.ancestors(role) {
+		if ancestor.name == user {
+			return invalidArgument("adding %s -> %s would create the inheritance cycle %s", user, role, strings.Join(append(ancestor.path, role), " -> "))
+		}
+	}
+
+	if s.opts.MaxRoleDepth > 0 {
+		depth := len(g.longestChain(user, g.children)) + len(g.longestChain(role, g.parents)) - 1
+		if depth > s.opts.MaxRoleDepth {
+			return invalidArgument("adding %s -> %s would create an inheritance chain of depth %d, the maximum is %d", user, role, depth, s.opts.MaxRoleDepth)
+		}
+	}
+
+	return nil
+}
+
+// hasPermissions determines whether name is the subject of any rule of the policy.
+func hasPermissions(m model.Model, name string, domain string) bool {
+	for ptype, ast := range m["p"] {
+		for _, rule := range ast.Policy {
+			if len(rule) > 0 && rule[0] == name && inDomain(m, ptype, rule, domain) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
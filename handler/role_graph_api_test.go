@@ -0,0 +1,68 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+)
+
+func TestExportRoleGraphFormat(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(DataDir("../models"))
+	h := newFileEnforcer(t, s, "rbac_model.conf", "rbac_policy.csv")
+
+	out := &pb.RoleGraphReply{}
+	if err := s.ExportRoleGraph(ctx, &pb.RoleGraphRequest{EnforcerHandler: h, Format: "dot"}, out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.Data, `"alice" -> "data2_admin"`) {
+		t.Errorf("ExportRoleGraph() = %s, want the alice -> data2_admin edge", out.Data)
+	}
+
+	err := s.ExportRoleGraph(ctx, &pb.RoleGraphRequest{EnforcerHandler: h, Format: "svg"}, &pb.RoleGraphReply{})
+	if !isInvalidArgument(err) {
+		t.Errorf("ExportRoleGraph(svg) error = %v, want %s", err, ErrorIDInvalidArgument)
+	}
+}
+
+func TestAddRoleForUserRejected(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(DataDir("../models"), MaxRoleDepth(2))
+	h := newFileEnforcer(t, s, "rbac_model.conf", "rbac_policy.csv")
+
+	// carol -> alice -> data2_admin is two hops, the maximum.
+	if err := s.AddRoleForUser(ctx, &pb.UserRoleRequest{EnforcerHandler: h, User: "carol", Role: "alice"}, &pb.BoolReply{}); err != nil {
+		t.Fatal(err)
+	}
+
+	links := []struct {
+		user string
+		role string
+	}{
+		{"bob", "bob"},                 // a self link
+		{"data2_admin", "carol"},       // the cycle carol -> alice -> data2_admin -> carol
+		{"data2_admin", "super_admin"}, // a chain of three hops
+	}
+	for _, link := range links {
+		err := s.AddRoleForUser(ctx, &pb.UserRoleRequest{EnforcerHandler: h, User: link.user, Role: link.role}, &pb.BoolReply{})
+		if !isInvalidArgument(err) {
+			t.Errorf("AddRoleForUser(%s, %s) error = %v, want %s", link.user, link.role, err, ErrorIDInvalidArgument)
+		}
+	}
+}
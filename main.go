@@ -1,26 +1,387 @@
 package main
 
 import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/micro/cli"
 	"github.com/micro/go-log"
 	"github.com/micro/go-micro"
+	"github.com/micro/go-micro/server"
+	"github.com/cicdi-go/casbin/auth"
+	"github.com/cicdi-go/casbin/gateway"
 	"github.com/cicdi-go/casbin/handler"
+	"github.com/cicdi-go/casbin/ratelimit"
+	"github.com/cicdi-go/casbin/secret"
 	"github.com/cicdi-go/casbin/subscriber"
+	"github.com/cicdi-go/casbin/version"
 
 	casbin "github.com/cicdi-go/casbin/proto/casbin"
 )
 
 func main() {
+	var opts []handler.Option
+	var checkInterval time.Duration
+	var srv *handler.Server
+	var wrappers []server.HandlerWrapper
+	var httpServer *http.Server
+	var gatewayOpts []gateway.Option
+	var healthServer *http.Server
+	stop := make(chan struct{})
+	redactor := secret.NewRedactor()
+
 	// New Service
 	service := micro.NewService(
 		micro.Name("go.micro.srv.casbin"),
-		micro.Version("latest"),
+		micro.Version(version.Version),
+		micro.Flags(
+			cli.IntFlag{
+				Name:   "max_role_depth",
+				EnvVar: "CASBIN_MAX_ROLE_DEPTH",
+				Value:  handler.DefaultMaxRoleDepth,
+				Usage:  "Maximum length of role inheritance chains, 0 disables the limit",
+			},
+			cli.StringFlag{
+				Name:   "data_dir",
+				EnvVar: "CASBIN_DATA_DIR",
+				Value:  handler.DefaultDataDir,
+				Usage:  "Directory holding the files of the file and bolt adapters, paths outside of it are rejected",
+			},
+			cli.StringFlag{
+				Name:   "http_address",
+				EnvVar: "CASBIN_HTTP_ADDRESS",
+				Usage:  "Address of the HTTP/JSON gateway, e.g. :8080, empty to not serve it",
+			},
+			cli.StringSliceFlag{
+				Name:   "http_header",
+				EnvVar: "CASBIN_HTTP_HEADERS",
+				Usage:  "HTTP header the gateway passes as metadata besides Authorization, e.g. X-Casbin-Principal for trusted clients",
+			},
+			cli.StringFlag{
+				Name:   "health_address",
+				EnvVar: "CASBIN_HEALTH_ADDRESS",
+				Usage:  "Address serving /healthz, /readyz and /version, e.g. :8081, empty to not serve them",
+			},
+			cli.DurationFlag{
+				Name:   "adapter_check_interval",
+				EnvVar: "CASBIN_ADAPTER_CHECK_INTERVAL",
+				Value:  30 * time.Second,
+				Usage:  "Interval between adapter health checks, 0 disables them",
+			},
+			cli.StringFlag{
+				Name:   "secrets_dir",
+				EnvVar: "CASBIN_SECRETS_DIR",
+				Usage:  "Directory holding one file per secret referenced by connection strings",
+			},
+			cli.StringFlag{
+				Name:   "secrets_file",
+				EnvVar: "CASBIN_SECRETS_FILE",
+				Usage:  "YAML or JSON file mapping secret names to values",
+			},
+			cli.StringFlag{
+				Name:   "meta_policy",
+				EnvVar: "CASBIN_META_POLICY",
+				Usage:  "Policy CSV file of the meta enforcer guarding the RPCs, no guard if empty",
+			},
+			cli.StringFlag{
+				Name:   "meta_model",
+				EnvVar: "CASBIN_META_MODEL",
+				Usage:  "Model file of the meta enforcer, a sub/obj/act model with roles if empty",
+			},
+			cli.BoolFlag{
+				Name:   "meta_check_reads",
+				EnvVar: "CASBIN_META_CHECK_READS",
+				Usage:  "Guard the read-only RPCs with the meta enforcer",
+			},
+			cli.BoolFlag{
+				Name:   "meta_check_enforce",
+				EnvVar: "CASBIN_META_CHECK_ENFORCE",
+				Usage:  "Guard the Enforce RPC with the meta enforcer",
+			},
+			cli.StringFlag{
+				Name:   "principal_key",
+				EnvVar: "CASBIN_PRINCIPAL_KEY",
+				Usage:  "Metadata key naming unauthenticated callers, X-Casbin-Principal by default unless JWT keys are configured",
+			},
+			cli.StringFlag{
+				Name:   "jwt_hmac_secret",
+				EnvVar: "CASBIN_JWT_HMAC_SECRET",
+				Usage:  "HMAC secret verifying bearer tokens, may be a ${secret:name} reference to a server secret",
+			},
+			cli.StringFlag{
+				Name:   "server_secrets_dir",
+				EnvVar: "CASBIN_SERVER_SECRETS_DIR",
+				Usage:  "Directory holding one file per server secret, out of the reach of connection strings",
+			},
+			cli.StringSliceFlag{
+				Name:   "jwt_public_key",
+				EnvVar: "CASBIN_JWT_PUBLIC_KEYS",
+				Usage:  "PEM file of an RSA or ECDSA public key verifying bearer tokens",
+			},
+			cli.StringSliceFlag{
+				Name:   "jwt_jwks",
+				EnvVar: "CASBIN_JWT_JWKS",
+				Usage:  "JWKS file of keys verifying bearer tokens",
+			},
+			cli.StringFlag{
+				Name:   "jwt_issuer",
+				EnvVar: "CASBIN_JWT_ISSUER",
+				Usage:  "Required iss claim of bearer tokens",
+			},
+			cli.StringFlag{
+				Name:   "jwt_audience",
+				EnvVar: "CASBIN_JWT_AUDIENCE",
+				Usage:  "Required aud claim of bearer tokens",
+			},
+			cli.BoolFlag{
+				Name:   "jwt_required",
+				EnvVar: "CASBIN_JWT_REQUIRED",
+				Usage:  "Reject the calls without a bearer token",
+			},
+			cli.BoolFlag{
+				Name:   "multi_tenant",
+				EnvVar: "CASBIN_MULTI_TENANT",
+				Usage:  "Scope enforcers and adapters to the tenant of the caller",
+			},
+			cli.StringFlag{
+				Name:   "tenant_claim",
+				EnvVar: "CASBIN_TENANT_CLAIM",
+				Value:  auth.DefaultTenantClaim,
+				Usage:  "Token claim naming the tenant of the caller",
+			},
+			cli.StringFlag{
+				Name:   "tenant_key",
+				EnvVar: "CASBIN_TENANT_KEY",
+				Usage:  "Metadata key naming the tenant of callers without a token, empty to only trust tokens",
+			},
+			cli.IntFlag{
+				Name:   "tenant_max_enforcers",
+				EnvVar: "CASBIN_TENANT_MAX_ENFORCERS",
+				Usage:  "Maximum number of enforcers of a tenant, 0 for no limit",
+			},
+			cli.IntFlag{
+				Name:   "tenant_max_rules",
+				EnvVar: "CASBIN_TENANT_MAX_RULES",
+				Usage:  "Maximum number of rules of an enforcer, 0 for no limit",
+			},
+			cli.IntFlag{
+				Name:   "tenant_rps",
+				EnvVar: "CASBIN_TENANT_RPS",
+				Usage:  "Maximum requests per second of a tenant, 0 for no limit",
+			},
+			cli.IntFlag{
+				Name:   "tenant_burst",
+				EnvVar: "CASBIN_TENANT_BURST",
+				Usage:  "Number of requests a tenant can make at once above its rate",
+			},
+			cli.StringFlag{
+				Name:   "tenant_quotas",
+				EnvVar: "CASBIN_TENANT_QUOTAS",
+				Usage:  "YAML or JSON file mapping tenants to quotas overriding the tenant_* flags",
+			},
+			cli.StringFlag{
+				Name:   "policy_topic",
+				EnvVar: "CASBIN_POLICY_TOPIC",
+				Value:  handler.DefaultPolicyTopic,
+				Usage:  "Topic of the policy change events, empty to not publish them",
+			},
+			cli.IntFlag{
+				Name:   "rate_limit",
+				EnvVar: "CASBIN_RATE_LIMIT",
+				Usage:  "Maximum requests per second of a caller, 0 for no limit",
+			},
+			cli.IntFlag{
+				Name:   "rate_burst",
+				EnvVar: "CASBIN_RATE_BURST",
+				Usage:  "Number of requests a caller can make at once above its rate",
+			},
+			cli.StringFlag{
+				Name:   "rate_limits",
+				EnvVar: "CASBIN_RATE_LIMITS",
+				Usage:  "YAML or JSON file mapping RPC names to the rate of every caller",
+			},
+			cli.IntFlag{
+				Name:   "max_params",
+				EnvVar: "CASBIN_MAX_PARAMS",
+				Value:  64,
+				Usage:  "Maximum number of params of a request, 0 for no limit",
+			},
+			cli.IntFlag{
+				Name:   "max_param_bytes",
+				EnvVar: "CASBIN_MAX_PARAM_BYTES",
+				Value:  4096,
+				Usage:  "Maximum length of a param of a request, 0 for no limit",
+			},
+			cli.IntFlag{
+				Name:   "max_json_bytes",
+				EnvVar: "CASBIN_MAX_JSON_BYTES",
+				Value:  64 << 10,
+				Usage:  "Maximum length of an ABAC JSON param of Enforce, 0 for no limit",
+			},
+			cli.IntFlag{
+				Name:   "max_batch_items",
+				EnvVar: "CASBIN_MAX_BATCH_ITEMS",
+				Value:  1000,
+				Usage:  "Maximum number of items of a batch request, 0 for no limit",
+			},
+			cli.IntFlag{
+				Name:   "max_batch_bytes",
+				EnvVar: "CASBIN_MAX_BATCH_BYTES",
+				Value:  4 << 20,
+				Usage:  "Maximum length of imported policy data, model text or an adapter connection string, 0 for no limit",
+			},
+		),
+		micro.WrapHandler(handler.RedactErrors(redactor)),
 	)
 
 	// Initialise service
-	service.Init()
+	service.Init(
+		micro.Action(func(c *cli.Context) {
+			opts = append(opts, handler.MaxRoleDepth(c.Int("max_role_depth")), handler.DataDir(c.String("data_dir")))
+			checkInterval = c.Duration("adapter_check_interval")
+			if address := c.String("http_address"); address != "" {
+				httpServer = &http.Server{Addr: address}
+				gatewayOpts = append(gatewayOpts,
+					gateway.Headers(c.StringSlice("http_header")...),
+					gateway.MaxBodyBytes(int64(c.Int("max_batch_bytes"))),
+				)
+			}
+			if address := c.String("health_address"); address != "" {
+				healthServer = &http.Server{Addr: address}
+			}
+
+			resolvers := []secret.Resolver{secret.Env(handler.DefaultSecretEnvPrefix)}
+			if dir := c.String("secrets_dir"); dir != "" {
+				resolvers = append(resolvers, secret.Dir(dir))
+			}
+			if path := c.String("secrets_file"); path != "" {
+				r, err := secret.File(path)
+				if err != nil {
+					log.Fatal(err)
+				}
+				resolvers = append(resolvers, r)
+			}
+			resolver := secret.Chain(resolvers...)
+			opts = append(opts, handler.SecretResolver(resolver), handler.Redactor(redactor))
+
+			// The secrets of the server are kept apart from the ones the connection strings
+			// of the callers can reference.
+			serverResolvers := []secret.Resolver{secret.Env(serverSecretEnvPrefix)}
+			if dir := c.String("server_secrets_dir"); dir != "" {
+				serverResolvers = append(serverResolvers, secret.Dir(dir))
+			}
+
+			keys, err := jwtKeys(c, secret.Chain(serverResolvers...), redactor)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !keys.Empty() {
+				wrappers = append(wrappers, auth.JWT(keys,
+					auth.Required(c.Bool("jwt_required")),
+					auth.Issuer(c.String("jwt_issuer")),
+					auth.Audience(c.String("jwt_audience")),
+				))
+			}
+
+			// Callers naming themselves in metadata would bypass the tokens, so the metadata
+			// is only trusted with JWT if the operator asks for it.
+			principalKey := c.String("principal_key")
+			if !c.IsSet("principal_key") && keys.Empty() {
+				principalKey = auth.DefaultPrincipalKey
+			}
+			if principalKey != "" && !keys.Empty() {
+				log.Logf("warning: both JWT and the %s metadata identify callers, callers without a token are trusted", principalKey)
+			}
+
+			if c.Bool("multi_tenant") {
+				wrappers = append(wrappers, auth.Tenancy(c.String("tenant_claim"), c.String("tenant_key")))
+			}
+
+			rates := []ratelimit.Option{ratelimit.Caller(ratelimit.Rate{
+				PerSecond: float64(c.Int("rate_limit")),
+				Burst:     c.Int("rate_burst"),
+			}), ratelimit.PrincipalKey(principalKey)}
+			if path := c.String("rate_limits"); path != "" {
+				methods, err := ratelimit.LoadMethodRates(path)
+				if err != nil {
+					log.Fatal(err)
+				}
+				rates = append(rates, ratelimit.Methods(methods))
+			}
+			wrappers = append(wrappers, ratelimit.Wrapper(rates...), ratelimit.SizeWrapper(ratelimit.Sizes{
+				MaxParams:     c.Int("max_params"),
+				MaxParamBytes: c.Int("max_param_bytes"),
+				MaxJSONBytes:  c.Int("max_json_bytes"),
+				MaxBatchItems: c.Int("max_batch_items"),
+				MaxBatchBytes: c.Int("max_batch_bytes"),
+			}))
+
+			opts = append(opts, handler.DefaultQuota(handler.Quota{
+				MaxEnforcers:      c.Int("tenant_max_enforcers"),
+				MaxRules:          c.Int("tenant_max_rules"),
+				RequestsPerSecond: float64(c.Int("tenant_rps")),
+				Burst:             c.Int("tenant_burst"),
+			}))
+			if path := c.String("tenant_quotas"); path != "" {
+				quotas, err := handler.LoadTenantQuotas(path)
+				if err != nil {
+					log.Fatal(err)
+				}
+				opts = append(opts, handler.TenantQuotas(quotas))
+			}
+
+			if path := c.String("meta_policy"); path != "" {
+				e, err := auth.NewMetaEnforcer(c.String("meta_model"), path)
+				if err != nil {
+					log.Fatal(err)
+				}
+				wrappers = append(wrappers, auth.MetaGuard(e,
+					auth.CheckReads(c.Bool("meta_check_reads")),
+					auth.CheckEnforce(c.Bool("meta_check_enforce")),
+					auth.PrincipalKey(principalKey),
+				))
+			}
+
+			if topic := c.String("policy_topic"); topic != "" {
+				wrappers = append(wrappers, handler.PublishChanges(micro.NewPublisher(topic, service.Client())))
+			}
+		}),
+		micro.AfterStart(func() error {
+			if checkInterval > 0 {
+				go watchAdapters(service.Server(), srv, checkInterval, stop)
+			}
+			if httpServer != nil {
+				httpServer.Handler = gateway.New("go.micro.srv.casbin", service.Client(), gatewayOpts...)
+				go serveHTTP(httpServer)
+			}
+			if healthServer != nil {
+				healthServer.Handler = srv.HealthHandler()
+				go serveHTTP(healthServer)
+			}
+			return nil
+		}),
+		micro.BeforeStop(func() error {
+			close(stop)
+			for _, hs := range []*http.Server{httpServer, healthServer} {
+				if hs != nil {
+					if err := hs.Shutdown(context.Background()); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}),
+	)
+
+	srv = handler.NewServer(opts...)
+
+	// Wrappers depending on flags
+	wrappers = append(wrappers, srv.LimitRequests)
+	service.Init(micro.WrapHandler(wrappers...))
 
 	// Register Handler
-	casbin.RegisterCasbinHandler(service.Server(), new(handler.Server))
+	casbin.RegisterCasbinHandler(service.Server(), srv)
 
 	// Register Struct as Subscriber
 	micro.RegisterSubscriber("go.micro.srv.casbin", service.Server(), new(subscriber.Casbin))
@@ -33,3 +394,79 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// watchAdapters deregisters the service while the backend of an adapter is unreachable,
+// so that the registry stops routing requests to this instance, and registers it again
+// once every backend is back.
+func watchAdapters(s server.Server, h *handler.Server, interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	healthy := true
+	for {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		err := h.CheckAdapters(ctx)
+		cancel()
+
+		switch {
+		case err != nil && healthy:
+			log.Logf("Adapter check failed, deregistering: %v", err)
+			if err := s.Deregister(); err != nil {
+				log.Logf("Deregister error: %v", err)
+				continue
+			}
+			healthy = false
+		case err == nil && !healthy:
+			log.Log("Adapters are healthy again, registering")
+			if err := s.Register(); err != nil {
+				log.Logf("Register error: %v", err)
+				continue
+			}
+			healthy = true
+		}
+	}
+}
+
+// serverSecretEnvPrefix is the prefix of the environment variables holding the secrets
+// of the server, e.g. the HMAC secret of the tokens.
+const serverSecretEnvPrefix = "CASBIN_SERVER_SECRET_"
+
+// jwtKeys loads the keys verifying bearer tokens given by the jwt_* flags, resolver
+// resolving the server secrets they reference.
+func jwtKeys(c *cli.Context, resolver secret.Resolver, redactor *secret.Redactor) (*auth.KeySet, error) {
+	keys := auth.NewKeySet()
+
+	if c.String("jwt_hmac_secret") != "" {
+		value, _, err := secret.Expand(c.String("jwt_hmac_secret"), resolver)
+		if err != nil {
+			return nil, err
+		}
+		redactor.Add(value)
+		keys.AddHMAC([]byte(value))
+	}
+	for _, path := range c.StringSlice("jwt_public_key") {
+		if err := keys.AddPEMFile(path); err != nil {
+			return nil, err
+		}
+	}
+	for _, path := range c.StringSlice("jwt_jwks") {
+		if err := keys.AddJWKSFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return keys, nil
+}
+
+// serveHTTP serves hs until it is shut down.
+func serveHTTP(hs *http.Server) {
+	if err := hs.ListenAndServe(); err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+}
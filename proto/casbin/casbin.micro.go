@@ -11,18 +11,49 @@ It has these top-level messages:
 	NewEnforcerRequest
 	NewEnforcerReply
 	NewAdapterRequest
+	PoolOptions
 	NewAdapterReply
+	AdapterDriver
+	AdapterDriversReply
+	CheckAdapterRequest
+	CheckAdapterReply
 	EnforceRequest
+	WhoCanRequest
+	ListAllowedRequest
 	BoolReply
 	EmptyRequest
+	ListRequest
 	EmptyReply
+	PolicyFilter
+	LoadFilteredPolicyRequest
+	ExportPolicyRequest
+	PolicyDataReply
+	ImportPolicyRequest
+	ImportPolicyReply
 	PolicyRequest
 	SimpleGetRequest
 	ArrayReply
 	FilteredPolicyRequest
+	PageOptions
 	UserRoleRequest
 	PermissionRequest
 	Array2DReply
+	InheritedRule
+	InheritedReply
+	RoleGraphRequest
+	RoleGraphReply
+	RolePath
+	CheckRoleGraphReply
+	PolicyFinding
+	AnalyzePolicyReply
+	ModelTemplate
+	ModelTemplatesReply
+	VersionReply
+	EnforcerStatus
+	AdapterStatus
+	HealthReply
+	ReadinessReply
+	PolicyChangeEvent
 	Message
 	StreamingRequest
 	StreamingResponse
@@ -62,16 +93,24 @@ var _ server.Option
 type CasbinService interface {
 	NewEnforcer(ctx context.Context, in *NewEnforcerRequest, opts ...client.CallOption) (*NewEnforcerReply, error)
 	NewAdapter(ctx context.Context, in *NewAdapterRequest, opts ...client.CallOption) (*NewAdapterReply, error)
+	ListAdapterDrivers(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*AdapterDriversReply, error)
+	CheckAdapter(ctx context.Context, in *CheckAdapterRequest, opts ...client.CallOption) (*CheckAdapterReply, error)
+	ListModelTemplates(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*ModelTemplatesReply, error)
 	Enforce(ctx context.Context, in *EnforceRequest, opts ...client.CallOption) (*BoolReply, error)
+	WhoCan(ctx context.Context, in *WhoCanRequest, opts ...client.CallOption) (*ArrayReply, error)
+	ListAllowed(ctx context.Context, in *ListAllowedRequest, opts ...client.CallOption) (*Array2DReply, error)
 	LoadPolicy(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*EmptyReply, error)
+	LoadFilteredPolicy(ctx context.Context, in *LoadFilteredPolicyRequest, opts ...client.CallOption) (*EmptyReply, error)
 	SavePolicy(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*EmptyReply, error)
+	ExportPolicy(ctx context.Context, in *ExportPolicyRequest, opts ...client.CallOption) (*PolicyDataReply, error)
+	ImportPolicy(ctx context.Context, in *ImportPolicyRequest, opts ...client.CallOption) (*ImportPolicyReply, error)
 	AddPolicy(ctx context.Context, in *PolicyRequest, opts ...client.CallOption) (*BoolReply, error)
 	AddNamedPolicy(ctx context.Context, in *PolicyRequest, opts ...client.CallOption) (*BoolReply, error)
 	RemovePolicy(ctx context.Context, in *PolicyRequest, opts ...client.CallOption) (*BoolReply, error)
 	RemoveNamedPolicy(ctx context.Context, in *PolicyRequest, opts ...client.CallOption) (*BoolReply, error)
 	RemoveFilteredPolicy(ctx context.Context, in *FilteredPolicyRequest, opts ...client.CallOption) (*BoolReply, error)
 	RemoveFilteredNamedPolicy(ctx context.Context, in *FilteredPolicyRequest, opts ...client.CallOption) (*BoolReply, error)
-	GetPolicy(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*Array2DReply, error)
+	GetPolicy(ctx context.Context, in *ListRequest, opts ...client.CallOption) (*Array2DReply, error)
 	GetNamedPolicy(ctx context.Context, in *PolicyRequest, opts ...client.CallOption) (*Array2DReply, error)
 	GetFilteredPolicy(ctx context.Context, in *FilteredPolicyRequest, opts ...client.CallOption) (*Array2DReply, error)
 	GetFilteredNamedPolicy(ctx context.Context, in *FilteredPolicyRequest, opts ...client.CallOption) (*Array2DReply, error)
@@ -81,7 +120,7 @@ type CasbinService interface {
 	RemoveNamedGroupingPolicy(ctx context.Context, in *PolicyRequest, opts ...client.CallOption) (*BoolReply, error)
 	RemoveFilteredGroupingPolicy(ctx context.Context, in *FilteredPolicyRequest, opts ...client.CallOption) (*BoolReply, error)
 	RemoveFilteredNamedGroupingPolicy(ctx context.Context, in *FilteredPolicyRequest, opts ...client.CallOption) (*BoolReply, error)
-	GetGroupingPolicy(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*Array2DReply, error)
+	GetGroupingPolicy(ctx context.Context, in *ListRequest, opts ...client.CallOption) (*Array2DReply, error)
 	GetNamedGroupingPolicy(ctx context.Context, in *PolicyRequest, opts ...client.CallOption) (*Array2DReply, error)
 	GetFilteredGroupingPolicy(ctx context.Context, in *FilteredPolicyRequest, opts ...client.CallOption) (*Array2DReply, error)
 	GetFilteredNamedGroupingPolicy(ctx context.Context, in *FilteredPolicyRequest, opts ...client.CallOption) (*Array2DReply, error)
@@ -97,6 +136,35 @@ type CasbinService interface {
 	HasNamedPolicy(ctx context.Context, in *PolicyRequest, opts ...client.CallOption) (*BoolReply, error)
 	HasGroupingPolicy(ctx context.Context, in *PolicyRequest, opts ...client.CallOption) (*BoolReply, error)
 	HasNamedGroupingPolicy(ctx context.Context, in *PolicyRequest, opts ...client.CallOption) (*BoolReply, error)
+	GetRolesForUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*ArrayReply, error)
+	GetUsersForRole(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*ArrayReply, error)
+	HasRoleForUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error)
+	AddRoleForUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error)
+	DeleteRoleForUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error)
+	DeleteRolesForUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error)
+	DeleteUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error)
+	DeleteRole(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*EmptyReply, error)
+	DeletePermission(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*BoolReply, error)
+	AddPermissionForUser(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*BoolReply, error)
+	DeletePermissionForUser(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*BoolReply, error)
+	DeletePermissionsForUser(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*BoolReply, error)
+	GetPermissionsForUser(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*Array2DReply, error)
+	HasPermissionForUser(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*BoolReply, error)
+	GetRolesForUserInDomain(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*ArrayReply, error)
+	GetUsersForRoleInDomain(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*ArrayReply, error)
+	AddRoleForUserInDomain(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error)
+	DeleteRoleForUserInDomain(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error)
+	GetPermissionsForUserInDomain(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*Array2DReply, error)
+	GetAllDomains(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*ArrayReply, error)
+	GetImplicitRolesForUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*InheritedReply, error)
+	GetImplicitPermissionsForUser(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*InheritedReply, error)
+	GetImplicitUsersForPermission(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*InheritedReply, error)
+	ExportRoleGraph(ctx context.Context, in *RoleGraphRequest, opts ...client.CallOption) (*RoleGraphReply, error)
+	CheckRoleGraph(ctx context.Context, in *RoleGraphRequest, opts ...client.CallOption) (*CheckRoleGraphReply, error)
+	AnalyzePolicy(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*AnalyzePolicyReply, error)
+	Health(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*HealthReply, error)
+	Readiness(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*ReadinessReply, error)
+	Version(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*VersionReply, error)
 }
 
 type casbinService struct {
@@ -137,6 +205,36 @@ func (c *casbinService) NewAdapter(ctx context.Context, in *NewAdapterRequest, o
 	return out, nil
 }
 
+func (c *casbinService) ListAdapterDrivers(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*AdapterDriversReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.ListAdapterDrivers", in)
+	out := new(AdapterDriversReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) CheckAdapter(ctx context.Context, in *CheckAdapterRequest, opts ...client.CallOption) (*CheckAdapterReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.CheckAdapter", in)
+	out := new(CheckAdapterReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) ListModelTemplates(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*ModelTemplatesReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.ListModelTemplates", in)
+	out := new(ModelTemplatesReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *casbinService) Enforce(ctx context.Context, in *EnforceRequest, opts ...client.CallOption) (*BoolReply, error) {
 	req := c.c.NewRequest(c.name, "Casbin.Enforce", in)
 	out := new(BoolReply)
@@ -147,6 +245,26 @@ func (c *casbinService) Enforce(ctx context.Context, in *EnforceRequest, opts ..
 	return out, nil
 }
 
+func (c *casbinService) WhoCan(ctx context.Context, in *WhoCanRequest, opts ...client.CallOption) (*ArrayReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.WhoCan", in)
+	out := new(ArrayReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) ListAllowed(ctx context.Context, in *ListAllowedRequest, opts ...client.CallOption) (*Array2DReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.ListAllowed", in)
+	out := new(Array2DReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *casbinService) LoadPolicy(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*EmptyReply, error) {
 	req := c.c.NewRequest(c.name, "Casbin.LoadPolicy", in)
 	out := new(EmptyReply)
@@ -157,6 +275,16 @@ func (c *casbinService) LoadPolicy(ctx context.Context, in *EmptyRequest, opts .
 	return out, nil
 }
 
+func (c *casbinService) LoadFilteredPolicy(ctx context.Context, in *LoadFilteredPolicyRequest, opts ...client.CallOption) (*EmptyReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.LoadFilteredPolicy", in)
+	out := new(EmptyReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *casbinService) SavePolicy(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*EmptyReply, error) {
 	req := c.c.NewRequest(c.name, "Casbin.SavePolicy", in)
 	out := new(EmptyReply)
@@ -167,6 +295,26 @@ func (c *casbinService) SavePolicy(ctx context.Context, in *EmptyRequest, opts .
 	return out, nil
 }
 
+func (c *casbinService) ExportPolicy(ctx context.Context, in *ExportPolicyRequest, opts ...client.CallOption) (*PolicyDataReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.ExportPolicy", in)
+	out := new(PolicyDataReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) ImportPolicy(ctx context.Context, in *ImportPolicyRequest, opts ...client.CallOption) (*ImportPolicyReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.ImportPolicy", in)
+	out := new(ImportPolicyReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *casbinService) AddPolicy(ctx context.Context, in *PolicyRequest, opts ...client.CallOption) (*BoolReply, error) {
 	req := c.c.NewRequest(c.name, "Casbin.AddPolicy", in)
 	out := new(BoolReply)
@@ -227,7 +375,7 @@ func (c *casbinService) RemoveFilteredNamedPolicy(ctx context.Context, in *Filte
 	return out, nil
 }
 
-func (c *casbinService) GetPolicy(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*Array2DReply, error) {
+func (c *casbinService) GetPolicy(ctx context.Context, in *ListRequest, opts ...client.CallOption) (*Array2DReply, error) {
 	req := c.c.NewRequest(c.name, "Casbin.GetPolicy", in)
 	out := new(Array2DReply)
 	err := c.c.Call(ctx, req, out, opts...)
@@ -327,7 +475,7 @@ func (c *casbinService) RemoveFilteredNamedGroupingPolicy(ctx context.Context, i
 	return out, nil
 }
 
-func (c *casbinService) GetGroupingPolicy(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*Array2DReply, error) {
+func (c *casbinService) GetGroupingPolicy(ctx context.Context, in *ListRequest, opts ...client.CallOption) (*Array2DReply, error) {
 	req := c.c.NewRequest(c.name, "Casbin.GetGroupingPolicy", in)
 	out := new(Array2DReply)
 	err := c.c.Call(ctx, req, out, opts...)
@@ -487,21 +635,319 @@ func (c *casbinService) HasNamedGroupingPolicy(ctx context.Context, in *PolicyRe
 	return out, nil
 }
 
+func (c *casbinService) GetRolesForUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*ArrayReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.GetRolesForUser", in)
+	out := new(ArrayReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) GetUsersForRole(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*ArrayReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.GetUsersForRole", in)
+	out := new(ArrayReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) HasRoleForUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.HasRoleForUser", in)
+	out := new(BoolReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) AddRoleForUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.AddRoleForUser", in)
+	out := new(BoolReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) DeleteRoleForUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.DeleteRoleForUser", in)
+	out := new(BoolReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) DeleteRolesForUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.DeleteRolesForUser", in)
+	out := new(BoolReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) DeleteUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.DeleteUser", in)
+	out := new(BoolReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) DeleteRole(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*EmptyReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.DeleteRole", in)
+	out := new(EmptyReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) DeletePermission(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*BoolReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.DeletePermission", in)
+	out := new(BoolReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) AddPermissionForUser(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*BoolReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.AddPermissionForUser", in)
+	out := new(BoolReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) DeletePermissionForUser(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*BoolReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.DeletePermissionForUser", in)
+	out := new(BoolReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) DeletePermissionsForUser(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*BoolReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.DeletePermissionsForUser", in)
+	out := new(BoolReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) GetPermissionsForUser(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*Array2DReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.GetPermissionsForUser", in)
+	out := new(Array2DReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) HasPermissionForUser(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*BoolReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.HasPermissionForUser", in)
+	out := new(BoolReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) GetRolesForUserInDomain(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*ArrayReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.GetRolesForUserInDomain", in)
+	out := new(ArrayReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) GetUsersForRoleInDomain(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*ArrayReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.GetUsersForRoleInDomain", in)
+	out := new(ArrayReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) AddRoleForUserInDomain(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.AddRoleForUserInDomain", in)
+	out := new(BoolReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) DeleteRoleForUserInDomain(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*BoolReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.DeleteRoleForUserInDomain", in)
+	out := new(BoolReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) GetPermissionsForUserInDomain(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*Array2DReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.GetPermissionsForUserInDomain", in)
+	out := new(Array2DReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) GetAllDomains(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*ArrayReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.GetAllDomains", in)
+	out := new(ArrayReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) GetImplicitRolesForUser(ctx context.Context, in *UserRoleRequest, opts ...client.CallOption) (*InheritedReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.GetImplicitRolesForUser", in)
+	out := new(InheritedReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) GetImplicitPermissionsForUser(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*InheritedReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.GetImplicitPermissionsForUser", in)
+	out := new(InheritedReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) GetImplicitUsersForPermission(ctx context.Context, in *PermissionRequest, opts ...client.CallOption) (*InheritedReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.GetImplicitUsersForPermission", in)
+	out := new(InheritedReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) ExportRoleGraph(ctx context.Context, in *RoleGraphRequest, opts ...client.CallOption) (*RoleGraphReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.ExportRoleGraph", in)
+	out := new(RoleGraphReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) CheckRoleGraph(ctx context.Context, in *RoleGraphRequest, opts ...client.CallOption) (*CheckRoleGraphReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.CheckRoleGraph", in)
+	out := new(CheckRoleGraphReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) AnalyzePolicy(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*AnalyzePolicyReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.AnalyzePolicy", in)
+	out := new(AnalyzePolicyReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) Health(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*HealthReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.Health", in)
+	out := new(HealthReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) Readiness(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*ReadinessReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.Readiness", in)
+	out := new(ReadinessReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinService) Version(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*VersionReply, error) {
+	req := c.c.NewRequest(c.name, "Casbin.Version", in)
+	out := new(VersionReply)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Casbin service
 
 type CasbinHandler interface {
 	NewEnforcer(context.Context, *NewEnforcerRequest, *NewEnforcerReply) error
 	NewAdapter(context.Context, *NewAdapterRequest, *NewAdapterReply) error
+	ListAdapterDrivers(context.Context, *EmptyRequest, *AdapterDriversReply) error
+	CheckAdapter(context.Context, *CheckAdapterRequest, *CheckAdapterReply) error
+	ListModelTemplates(context.Context, *EmptyRequest, *ModelTemplatesReply) error
 	Enforce(context.Context, *EnforceRequest, *BoolReply) error
+	WhoCan(context.Context, *WhoCanRequest, *ArrayReply) error
+	ListAllowed(context.Context, *ListAllowedRequest, *Array2DReply) error
 	LoadPolicy(context.Context, *EmptyRequest, *EmptyReply) error
+	LoadFilteredPolicy(context.Context, *LoadFilteredPolicyRequest, *EmptyReply) error
 	SavePolicy(context.Context, *EmptyRequest, *EmptyReply) error
+	ExportPolicy(context.Context, *ExportPolicyRequest, *PolicyDataReply) error
+	ImportPolicy(context.Context, *ImportPolicyRequest, *ImportPolicyReply) error
 	AddPolicy(context.Context, *PolicyRequest, *BoolReply) error
 	AddNamedPolicy(context.Context, *PolicyRequest, *BoolReply) error
 	RemovePolicy(context.Context, *PolicyRequest, *BoolReply) error
 	RemoveNamedPolicy(context.Context, *PolicyRequest, *BoolReply) error
 	RemoveFilteredPolicy(context.Context, *FilteredPolicyRequest, *BoolReply) error
 	RemoveFilteredNamedPolicy(context.Context, *FilteredPolicyRequest, *BoolReply) error
-	GetPolicy(context.Context, *EmptyRequest, *Array2DReply) error
+	GetPolicy(context.Context, *ListRequest, *Array2DReply) error
 	GetNamedPolicy(context.Context, *PolicyRequest, *Array2DReply) error
 	GetFilteredPolicy(context.Context, *FilteredPolicyRequest, *Array2DReply) error
 	GetFilteredNamedPolicy(context.Context, *FilteredPolicyRequest, *Array2DReply) error
@@ -511,7 +957,7 @@ type CasbinHandler interface {
 	RemoveNamedGroupingPolicy(context.Context, *PolicyRequest, *BoolReply) error
 	RemoveFilteredGroupingPolicy(context.Context, *FilteredPolicyRequest, *BoolReply) error
 	RemoveFilteredNamedGroupingPolicy(context.Context, *FilteredPolicyRequest, *BoolReply) error
-	GetGroupingPolicy(context.Context, *EmptyRequest, *Array2DReply) error
+	GetGroupingPolicy(context.Context, *ListRequest, *Array2DReply) error
 	GetNamedGroupingPolicy(context.Context, *PolicyRequest, *Array2DReply) error
 	GetFilteredGroupingPolicy(context.Context, *FilteredPolicyRequest, *Array2DReply) error
 	GetFilteredNamedGroupingPolicy(context.Context, *FilteredPolicyRequest, *Array2DReply) error
@@ -527,22 +973,59 @@ type CasbinHandler interface {
 	HasNamedPolicy(context.Context, *PolicyRequest, *BoolReply) error
 	HasGroupingPolicy(context.Context, *PolicyRequest, *BoolReply) error
 	HasNamedGroupingPolicy(context.Context, *PolicyRequest, *BoolReply) error
+	GetRolesForUser(context.Context, *UserRoleRequest, *ArrayReply) error
+	GetUsersForRole(context.Context, *UserRoleRequest, *ArrayReply) error
+	HasRoleForUser(context.Context, *UserRoleRequest, *BoolReply) error
+	AddRoleForUser(context.Context, *UserRoleRequest, *BoolReply) error
+	DeleteRoleForUser(context.Context, *UserRoleRequest, *BoolReply) error
+	DeleteRolesForUser(context.Context, *UserRoleRequest, *BoolReply) error
+	DeleteUser(context.Context, *UserRoleRequest, *BoolReply) error
+	DeleteRole(context.Context, *UserRoleRequest, *EmptyReply) error
+	DeletePermission(context.Context, *PermissionRequest, *BoolReply) error
+	AddPermissionForUser(context.Context, *PermissionRequest, *BoolReply) error
+	DeletePermissionForUser(context.Context, *PermissionRequest, *BoolReply) error
+	DeletePermissionsForUser(context.Context, *PermissionRequest, *BoolReply) error
+	GetPermissionsForUser(context.Context, *PermissionRequest, *Array2DReply) error
+	HasPermissionForUser(context.Context, *PermissionRequest, *BoolReply) error
+	GetRolesForUserInDomain(context.Context, *UserRoleRequest, *ArrayReply) error
+	GetUsersForRoleInDomain(context.Context, *UserRoleRequest, *ArrayReply) error
+	AddRoleForUserInDomain(context.Context, *UserRoleRequest, *BoolReply) error
+	DeleteRoleForUserInDomain(context.Context, *UserRoleRequest, *BoolReply) error
+	GetPermissionsForUserInDomain(context.Context, *PermissionRequest, *Array2DReply) error
+	GetAllDomains(context.Context, *EmptyRequest, *ArrayReply) error
+	GetImplicitRolesForUser(context.Context, *UserRoleRequest, *InheritedReply) error
+	GetImplicitPermissionsForUser(context.Context, *PermissionRequest, *InheritedReply) error
+	GetImplicitUsersForPermission(context.Context, *PermissionRequest, *InheritedReply) error
+	ExportRoleGraph(context.Context, *RoleGraphRequest, *RoleGraphReply) error
+	CheckRoleGraph(context.Context, *RoleGraphRequest, *CheckRoleGraphReply) error
+	AnalyzePolicy(context.Context, *EmptyRequest, *AnalyzePolicyReply) error
+	Health(context.Context, *EmptyRequest, *HealthReply) error
+	Readiness(context.Context, *EmptyRequest, *ReadinessReply) error
+	Version(context.Context, *EmptyRequest, *VersionReply) error
 }
 
 func RegisterCasbinHandler(s server.Server, hdlr CasbinHandler, opts ...server.HandlerOption) error {
 	type casbin interface {
 		NewEnforcer(ctx context.Context, in *NewEnforcerRequest, out *NewEnforcerReply) error
 		NewAdapter(ctx context.Context, in *NewAdapterRequest, out *NewAdapterReply) error
+		ListAdapterDrivers(ctx context.Context, in *EmptyRequest, out *AdapterDriversReply) error
+		CheckAdapter(ctx context.Context, in *CheckAdapterRequest, out *CheckAdapterReply) error
+		ListModelTemplates(ctx context.Context, in *EmptyRequest, out *ModelTemplatesReply) error
 		Enforce(ctx context.Context, in *EnforceRequest, out *BoolReply) error
+		WhoCan(ctx context.Context, in *WhoCanRequest, out *ArrayReply) error
+		ListAllowed(ctx context.Context, in *ListAllowedRequest, out *Array2DReply) error
 		LoadPolicy(ctx context.Context, in *EmptyRequest, out *EmptyReply) error
+		LoadFilteredPolicy(ctx context.Context, in *LoadFilteredPolicyRequest, out *EmptyReply) error
 		SavePolicy(ctx context.Context, in *EmptyRequest, out *EmptyReply) error
+		ExportPolicy(ctx context.Context, in *ExportPolicyRequest, out *PolicyDataReply) error
+		ImportPolicy(ctx context.Context, in *ImportPolicyRequest, out *ImportPolicyReply) error
 		AddPolicy(ctx context.Context, in *PolicyRequest, out *BoolReply) error
 		AddNamedPolicy(ctx context.Context, in *PolicyRequest, out *BoolReply) error
 		RemovePolicy(ctx context.Context, in *PolicyRequest, out *BoolReply) error
 		RemoveNamedPolicy(ctx context.Context, in *PolicyRequest, out *BoolReply) error
 		RemoveFilteredPolicy(ctx context.Context, in *FilteredPolicyRequest, out *BoolReply) error
 		RemoveFilteredNamedPolicy(ctx context.Context, in *FilteredPolicyRequest, out *BoolReply) error
-		GetPolicy(ctx context.Context, in *EmptyRequest, out *Array2DReply) error
+		GetPolicy(ctx context.Context, in *ListRequest, out *Array2DReply) error
 		GetNamedPolicy(ctx context.Context, in *PolicyRequest, out *Array2DReply) error
 		GetFilteredPolicy(ctx context.Context, in *FilteredPolicyRequest, out *Array2DReply) error
 		GetFilteredNamedPolicy(ctx context.Context, in *FilteredPolicyRequest, out *Array2DReply) error
@@ -552,7 +1035,7 @@ func RegisterCasbinHandler(s server.Server, hdlr CasbinHandler, opts ...server.H
 		RemoveNamedGroupingPolicy(ctx context.Context, in *PolicyRequest, out *BoolReply) error
 		RemoveFilteredGroupingPolicy(ctx context.Context, in *FilteredPolicyRequest, out *BoolReply) error
 		RemoveFilteredNamedGroupingPolicy(ctx context.Context, in *FilteredPolicyRequest, out *BoolReply) error
-		GetGroupingPolicy(ctx context.Context, in *EmptyRequest, out *Array2DReply) error
+		GetGroupingPolicy(ctx context.Context, in *ListRequest, out *Array2DReply) error
 		GetNamedGroupingPolicy(ctx context.Context, in *PolicyRequest, out *Array2DReply) error
 		GetFilteredGroupingPolicy(ctx context.Context, in *FilteredPolicyRequest, out *Array2DReply) error
 		GetFilteredNamedGroupingPolicy(ctx context.Context, in *FilteredPolicyRequest, out *Array2DReply) error
@@ -568,6 +1051,35 @@ func RegisterCasbinHandler(s server.Server, hdlr CasbinHandler, opts ...server.H
 		HasNamedPolicy(ctx context.Context, in *PolicyRequest, out *BoolReply) error
 		HasGroupingPolicy(ctx context.Context, in *PolicyRequest, out *BoolReply) error
 		HasNamedGroupingPolicy(ctx context.Context, in *PolicyRequest, out *BoolReply) error
+		GetRolesForUser(ctx context.Context, in *UserRoleRequest, out *ArrayReply) error
+		GetUsersForRole(ctx context.Context, in *UserRoleRequest, out *ArrayReply) error
+		HasRoleForUser(ctx context.Context, in *UserRoleRequest, out *BoolReply) error
+		AddRoleForUser(ctx context.Context, in *UserRoleRequest, out *BoolReply) error
+		DeleteRoleForUser(ctx context.Context, in *UserRoleRequest, out *BoolReply) error
+		DeleteRolesForUser(ctx context.Context, in *UserRoleRequest, out *BoolReply) error
+		DeleteUser(ctx context.Context, in *UserRoleRequest, out *BoolReply) error
+		DeleteRole(ctx context.Context, in *UserRoleRequest, out *EmptyReply) error
+		DeletePermission(ctx context.Context, in *PermissionRequest, out *BoolReply) error
+		AddPermissionForUser(ctx context.Context, in *PermissionRequest, out *BoolReply) error
+		DeletePermissionForUser(ctx context.Context, in *PermissionRequest, out *BoolReply) error
+		DeletePermissionsForUser(ctx context.Context, in *PermissionRequest, out *BoolReply) error
+		GetPermissionsForUser(ctx context.Context, in *PermissionRequest, out *Array2DReply) error
+		HasPermissionForUser(ctx context.Context, in *PermissionRequest, out *BoolReply) error
+		GetRolesForUserInDomain(ctx context.Context, in *UserRoleRequest, out *ArrayReply) error
+		GetUsersForRoleInDomain(ctx context.Context, in *UserRoleRequest, out *ArrayReply) error
+		AddRoleForUserInDomain(ctx context.Context, in *UserRoleRequest, out *BoolReply) error
+		DeleteRoleForUserInDomain(ctx context.Context, in *UserRoleRequest, out *BoolReply) error
+		GetPermissionsForUserInDomain(ctx context.Context, in *PermissionRequest, out *Array2DReply) error
+		GetAllDomains(ctx context.Context, in *EmptyRequest, out *ArrayReply) error
+		GetImplicitRolesForUser(ctx context.Context, in *UserRoleRequest, out *InheritedReply) error
+		GetImplicitPermissionsForUser(ctx context.Context, in *PermissionRequest, out *InheritedReply) error
+		GetImplicitUsersForPermission(ctx context.Context, in *PermissionRequest, out *InheritedReply) error
+		ExportRoleGraph(ctx context.Context, in *RoleGraphRequest, out *RoleGraphReply) error
+		CheckRoleGraph(ctx context.Context, in *RoleGraphRequest, out *CheckRoleGraphReply) error
+		AnalyzePolicy(ctx context.Context, in *EmptyRequest, out *AnalyzePolicyReply) error
+		Health(ctx context.Context, in *EmptyRequest, out *HealthReply) error
+		Readiness(ctx context.Context, in *EmptyRequest, out *ReadinessReply) error
+		Version(ctx context.Context, in *EmptyRequest, out *VersionReply) error
 	}
 	type Casbin struct {
 		casbin
@@ -588,18 +1100,50 @@ func (h *casbinHandler) NewAdapter(ctx context.Context, in *NewAdapterRequest, o
 	return h.CasbinHandler.NewAdapter(ctx, in, out)
 }
 
+func (h *casbinHandler) ListAdapterDrivers(ctx context.Context, in *EmptyRequest, out *AdapterDriversReply) error {
+	return h.CasbinHandler.ListAdapterDrivers(ctx, in, out)
+}
+
+func (h *casbinHandler) CheckAdapter(ctx context.Context, in *CheckAdapterRequest, out *CheckAdapterReply) error {
+	return h.CasbinHandler.CheckAdapter(ctx, in, out)
+}
+
+func (h *casbinHandler) ListModelTemplates(ctx context.Context, in *EmptyRequest, out *ModelTemplatesReply) error {
+	return h.CasbinHandler.ListModelTemplates(ctx, in, out)
+}
+
 func (h *casbinHandler) Enforce(ctx context.Context, in *EnforceRequest, out *BoolReply) error {
 	return h.CasbinHandler.Enforce(ctx, in, out)
 }
 
+func (h *casbinHandler) WhoCan(ctx context.Context, in *WhoCanRequest, out *ArrayReply) error {
+	return h.CasbinHandler.WhoCan(ctx, in, out)
+}
+
+func (h *casbinHandler) ListAllowed(ctx context.Context, in *ListAllowedRequest, out *Array2DReply) error {
+	return h.CasbinHandler.ListAllowed(ctx, in, out)
+}
+
 func (h *casbinHandler) LoadPolicy(ctx context.Context, in *EmptyRequest, out *EmptyReply) error {
 	return h.CasbinHandler.LoadPolicy(ctx, in, out)
 }
 
+func (h *casbinHandler) LoadFilteredPolicy(ctx context.Context, in *LoadFilteredPolicyRequest, out *EmptyReply) error {
+	return h.CasbinHandler.LoadFilteredPolicy(ctx, in, out)
+}
+
 func (h *casbinHandler) SavePolicy(ctx context.Context, in *EmptyRequest, out *EmptyReply) error {
 	return h.CasbinHandler.SavePolicy(ctx, in, out)
 }
 
+func (h *casbinHandler) ExportPolicy(ctx context.Context, in *ExportPolicyRequest, out *PolicyDataReply) error {
+	return h.CasbinHandler.ExportPolicy(ctx, in, out)
+}
+
+func (h *casbinHandler) ImportPolicy(ctx context.Context, in *ImportPolicyRequest, out *ImportPolicyReply) error {
+	return h.CasbinHandler.ImportPolicy(ctx, in, out)
+}
+
 func (h *casbinHandler) AddPolicy(ctx context.Context, in *PolicyRequest, out *BoolReply) error {
 	return h.CasbinHandler.AddPolicy(ctx, in, out)
 }
@@ -624,7 +1168,7 @@ func (h *casbinHandler) RemoveFilteredNamedPolicy(ctx context.Context, in *Filte
 	return h.CasbinHandler.RemoveFilteredNamedPolicy(ctx, in, out)
 }
 
-func (h *casbinHandler) GetPolicy(ctx context.Context, in *EmptyRequest, out *Array2DReply) error {
+func (h *casbinHandler) GetPolicy(ctx context.Context, in *ListRequest, out *Array2DReply) error {
 	return h.CasbinHandler.GetPolicy(ctx, in, out)
 }
 
@@ -664,7 +1208,7 @@ func (h *casbinHandler) RemoveFilteredNamedGroupingPolicy(ctx context.Context, i
 	return h.CasbinHandler.RemoveFilteredNamedGroupingPolicy(ctx, in, out)
 }
 
-func (h *casbinHandler) GetGroupingPolicy(ctx context.Context, in *EmptyRequest, out *Array2DReply) error {
+func (h *casbinHandler) GetGroupingPolicy(ctx context.Context, in *ListRequest, out *Array2DReply) error {
 	return h.CasbinHandler.GetGroupingPolicy(ctx, in, out)
 }
 
@@ -727,3 +1271,119 @@ func (h *casbinHandler) HasGroupingPolicy(ctx context.Context, in *PolicyRequest
 func (h *casbinHandler) HasNamedGroupingPolicy(ctx context.Context, in *PolicyRequest, out *BoolReply) error {
 	return h.CasbinHandler.HasNamedGroupingPolicy(ctx, in, out)
 }
+
+func (h *casbinHandler) GetRolesForUser(ctx context.Context, in *UserRoleRequest, out *ArrayReply) error {
+	return h.CasbinHandler.GetRolesForUser(ctx, in, out)
+}
+
+func (h *casbinHandler) GetUsersForRole(ctx context.Context, in *UserRoleRequest, out *ArrayReply) error {
+	return h.CasbinHandler.GetUsersForRole(ctx, in, out)
+}
+
+func (h *casbinHandler) HasRoleForUser(ctx context.Context, in *UserRoleRequest, out *BoolReply) error {
+	return h.CasbinHandler.HasRoleForUser(ctx, in, out)
+}
+
+func (h *casbinHandler) AddRoleForUser(ctx context.Context, in *UserRoleRequest, out *BoolReply) error {
+	return h.CasbinHandler.AddRoleForUser(ctx, in, out)
+}
+
+func (h *casbinHandler) DeleteRoleForUser(ctx context.Context, in *UserRoleRequest, out *BoolReply) error {
+	return h.CasbinHandler.DeleteRoleForUser(ctx, in, out)
+}
+
+func (h *casbinHandler) DeleteRolesForUser(ctx context.Context, in *UserRoleRequest, out *BoolReply) error {
+	return h.CasbinHandler.DeleteRolesForUser(ctx, in, out)
+}
+
+func (h *casbinHandler) DeleteUser(ctx context.Context, in *UserRoleRequest, out *BoolReply) error {
+	return h.CasbinHandler.DeleteUser(ctx, in, out)
+}
+
+func (h *casbinHandler) DeleteRole(ctx context.Context, in *UserRoleRequest, out *EmptyReply) error {
+	return h.CasbinHandler.DeleteRole(ctx, in, out)
+}
+
+func (h *casbinHandler) DeletePermission(ctx context.Context, in *PermissionRequest, out *BoolReply) error {
+	return h.CasbinHandler.DeletePermission(ctx, in, out)
+}
+
+func (h *casbinHandler) AddPermissionForUser(ctx context.Context, in *PermissionRequest, out *BoolReply) error {
+	return h.CasbinHandler.AddPermissionForUser(ctx, in, out)
+}
+
+func (h *casbinHandler) DeletePermissionForUser(ctx context.Context, in *PermissionRequest, out *BoolReply) error {
+	return h.CasbinHandler.DeletePermissionForUser(ctx, in, out)
+}
+
+func (h *casbinHandler) DeletePermissionsForUser(ctx context.Context, in *PermissionRequest, out *BoolReply) error {
+	return h.CasbinHandler.DeletePermissionsForUser(ctx, in, out)
+}
+
+func (h *casbinHandler) GetPermissionsForUser(ctx context.Context, in *PermissionRequest, out *Array2DReply) error {
+	return h.CasbinHandler.GetPermissionsForUser(ctx, in, out)
+}
+
+func (h *casbinHandler) HasPermissionForUser(ctx context.Context, in *PermissionRequest, out *BoolReply) error {
+	return h.CasbinHandler.HasPermissionForUser(ctx, in, out)
+}
+
+func (h *casbinHandler) GetRolesForUserInDomain(ctx context.Context, in *UserRoleRequest, out *ArrayReply) error {
+	return h.CasbinHandler.GetRolesForUserInDomain(ctx, in, out)
+}
+
+func (h *casbinHandler) GetUsersForRoleInDomain(ctx context.Context, in *UserRoleRequest, out *ArrayReply) error {
+	return h.CasbinHandler.GetUsersForRoleInDomain(ctx, in, out)
+}
+
+func (h *casbinHandler) AddRoleForUserInDomain(ctx context.Context, in *UserRoleRequest, out *BoolReply) error {
+	return h.CasbinHandler.AddRoleForUserInDomain(ctx, in, out)
+}
+
+func (h *casbinHandler) DeleteRoleForUserInDomain(ctx context.Context, in *UserRoleRequest, out *BoolReply) error {
+	return h.CasbinHandler.DeleteRoleForUserInDomain(ctx, in, out)
+}
+
+func (h *casbinHandler) GetPermissionsForUserInDomain(ctx context.Context, in *PermissionRequest, out *Array2DReply) error {
+	return h.CasbinHandler.GetPermissionsForUserInDomain(ctx, in, out)
+}
+
+func (h *casbinHandler) GetAllDomains(ctx context.Context, in *EmptyRequest, out *ArrayReply) error {
+	return h.CasbinHandler.GetAllDomains(ctx, in, out)
+}
+
+func (h *casbinHandler) GetImplicitRolesForUser(ctx context.Context, in *UserRoleRequest, out *InheritedReply) error {
+	return h.CasbinHandler.GetImplicitRolesForUser(ctx, in, out)
+}
+
+func (h *casbinHandler) GetImplicitPermissionsForUser(ctx context.Context, in *PermissionRequest, out *InheritedReply) error {
+	return h.CasbinHandler.GetImplicitPermissionsForUser(ctx, in, out)
+}
+
+func (h *casbinHandler) GetImplicitUsersForPermission(ctx context.Context, in *PermissionRequest, out *InheritedReply) error {
+	return h.CasbinHandler.GetImplicitUsersForPermission(ctx, in, out)
+}
+
+func (h *casbinHandler) ExportRoleGraph(ctx context.Context, in *RoleGraphRequest, out *RoleGraphReply) error {
+	return h.CasbinHandler.ExportRoleGraph(ctx, in, out)
+}
+
+func (h *casbinHandler) CheckRoleGraph(ctx context.Context, in *RoleGraphRequest, out *CheckRoleGraphReply) error {
+	return h.CasbinHandler.CheckRoleGraph(ctx, in, out)
+}
+
+func (h *casbinHandler) AnalyzePolicy(ctx context.Context, in *EmptyRequest, out *AnalyzePolicyReply) error {
+	return h.CasbinHandler.AnalyzePolicy(ctx, in, out)
+}
+
+func (h *casbinHandler) Health(ctx context.Context, in *EmptyRequest, out *HealthReply) error {
+	return h.CasbinHandler.Health(ctx, in, out)
+}
+
+func (h *casbinHandler) Readiness(ctx context.Context, in *EmptyRequest, out *ReadinessReply) error {
+	return h.CasbinHandler.Readiness(ctx, in, out)
+}
+
+func (h *casbinHandler) Version(ctx context.Context, in *EmptyRequest, out *VersionReply) error {
+	return h.CasbinHandler.Version(ctx, in, out)
+}
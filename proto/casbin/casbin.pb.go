@@ -23,6 +23,7 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type NewEnforcerRequest struct {
 	ModelText            string   `protobuf:"bytes,1,opt,name=modelText,proto3" json:"modelText,omitempty"`
 	AdapterHandle        int32    `protobuf:"varint,2,opt,name=adapterHandle,proto3" json:"adapterHandle,omitempty"`
+	ModelTemplate        string   `protobuf:"bytes,3,opt,name=modelTemplate,proto3" json:"modelTemplate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -67,8 +68,18 @@ func (m *NewEnforcerRequest) GetAdapterHandle() int32 {
 	return 0
 }
 
+func (m *NewEnforcerRequest) GetModelTemplate() string {
+	if m != nil {
+		return m.ModelTemplate
+	}
+	return ""
+}
+
 type NewEnforcerReply struct {
-	Handler              int32    `protobuf:"varint,1,opt,name=handler,proto3" json:"handler,omitempty"`
+	Handler int32 `protobuf:"varint,1,opt,name=handler,proto3" json:"handler,omitempty"`
+	// loadError is set when the enforcer was created but its policy failed to load
+	// from the adapter, LoadPolicy retries.
+	LoadError            string   `protobuf:"bytes,2,opt,name=loadError,proto3" json:"loadError,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -106,13 +117,22 @@ func (m *NewEnforcerReply) GetHandler() int32 {
 	return 0
 }
 
+func (m *NewEnforcerReply) GetLoadError() string {
+	if m != nil {
+		return m.LoadError
+	}
+	return ""
+}
+
 type NewAdapterRequest struct {
-	AdapterName          string   `protobuf:"bytes,1,opt,name=adapterName,proto3" json:"adapterName,omitempty"`
-	DriverName           string   `protobuf:"bytes,2,opt,name=driverName,proto3" json:"driverName,omitempty"`
-	ConnectString        string   `protobuf:"bytes,3,opt,name=connectString,proto3" json:"connectString,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AdapterName          string            `protobuf:"bytes,1,opt,name=adapterName,proto3" json:"adapterName,omitempty"`
+	DriverName           string            `protobuf:"bytes,2,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	ConnectString        string            `protobuf:"bytes,3,opt,name=connectString,proto3" json:"connectString,omitempty"`
+	Params               map[string]string `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Pool                 *PoolOptions      `protobuf:"bytes,5,opt,name=pool,proto3" json:"pool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *NewAdapterRequest) Reset()         { *m = NewAdapterRequest{} }
@@ -161,6 +181,83 @@ func (m *NewAdapterRequest) GetConnectString() string {
 	return ""
 }
 
+func (m *NewAdapterRequest) GetParams() map[string]string {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *NewAdapterRequest) GetPool() *PoolOptions {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+type PoolOptions struct {
+	MaxOpenConns         int32    `protobuf:"varint,1,opt,name=maxOpenConns,proto3" json:"maxOpenConns,omitempty"`
+	MaxIdleConns         int32    `protobuf:"varint,2,opt,name=maxIdleConns,proto3" json:"maxIdleConns,omitempty"`
+	ConnMaxLifetime      string   `protobuf:"bytes,3,opt,name=connMaxLifetime,proto3" json:"connMaxLifetime,omitempty"`
+	Timeout              string   `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PoolOptions) Reset()         { *m = PoolOptions{} }
+func (m *PoolOptions) String() string { return proto.CompactTextString(m) }
+func (*PoolOptions) ProtoMessage()    {}
+func (*PoolOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{3}
+}
+
+func (m *PoolOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolOptions.Unmarshal(m, b)
+}
+func (m *PoolOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolOptions.Marshal(b, m, deterministic)
+}
+func (m *PoolOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolOptions.Merge(m, src)
+}
+func (m *PoolOptions) XXX_Size() int {
+	return xxx_messageInfo_PoolOptions.Size(m)
+}
+func (m *PoolOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolOptions proto.InternalMessageInfo
+
+func (m *PoolOptions) GetMaxOpenConns() int32 {
+	if m != nil {
+		return m.MaxOpenConns
+	}
+	return 0
+}
+
+func (m *PoolOptions) GetMaxIdleConns() int32 {
+	if m != nil {
+		return m.MaxIdleConns
+	}
+	return 0
+}
+
+func (m *PoolOptions) GetConnMaxLifetime() string {
+	if m != nil {
+		return m.ConnMaxLifetime
+	}
+	return ""
+}
+
+func (m *PoolOptions) GetTimeout() string {
+	if m != nil {
+		return m.Timeout
+	}
+	return ""
+}
+
 type NewAdapterReply struct {
 	Handler              int32    `protobuf:"varint,1,opt,name=handler,proto3" json:"handler,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -172,7 +269,7 @@ func (m *NewAdapterReply) Reset()         { *m = NewAdapterReply{} }
 func (m *NewAdapterReply) String() string { return proto.CompactTextString(m) }
 func (*NewAdapterReply) ProtoMessage()    {}
 func (*NewAdapterReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{3}
+	return fileDescriptor_f835afd114115d9e, []int{4}
 }
 
 func (m *NewAdapterReply) XXX_Unmarshal(b []byte) error {
@@ -200,6 +297,178 @@ func (m *NewAdapterReply) GetHandler() int32 {
 	return 0
 }
 
+type AdapterDriver struct {
+	AdapterName          string   `protobuf:"bytes,1,opt,name=adapterName,proto3" json:"adapterName,omitempty"`
+	DriverName           string   `protobuf:"bytes,2,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdapterDriver) Reset()         { *m = AdapterDriver{} }
+func (m *AdapterDriver) String() string { return proto.CompactTextString(m) }
+func (*AdapterDriver) ProtoMessage()    {}
+func (*AdapterDriver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{5}
+}
+
+func (m *AdapterDriver) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdapterDriver.Unmarshal(m, b)
+}
+func (m *AdapterDriver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdapterDriver.Marshal(b, m, deterministic)
+}
+func (m *AdapterDriver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdapterDriver.Merge(m, src)
+}
+func (m *AdapterDriver) XXX_Size() int {
+	return xxx_messageInfo_AdapterDriver.Size(m)
+}
+func (m *AdapterDriver) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdapterDriver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdapterDriver proto.InternalMessageInfo
+
+func (m *AdapterDriver) GetAdapterName() string {
+	if m != nil {
+		return m.AdapterName
+	}
+	return ""
+}
+
+func (m *AdapterDriver) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+type AdapterDriversReply struct {
+	Drivers              []*AdapterDriver `protobuf:"bytes,1,rep,name=drivers,proto3" json:"drivers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AdapterDriversReply) Reset()         { *m = AdapterDriversReply{} }
+func (m *AdapterDriversReply) String() string { return proto.CompactTextString(m) }
+func (*AdapterDriversReply) ProtoMessage()    {}
+func (*AdapterDriversReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{6}
+}
+
+func (m *AdapterDriversReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdapterDriversReply.Unmarshal(m, b)
+}
+func (m *AdapterDriversReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdapterDriversReply.Marshal(b, m, deterministic)
+}
+func (m *AdapterDriversReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdapterDriversReply.Merge(m, src)
+}
+func (m *AdapterDriversReply) XXX_Size() int {
+	return xxx_messageInfo_AdapterDriversReply.Size(m)
+}
+func (m *AdapterDriversReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdapterDriversReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdapterDriversReply proto.InternalMessageInfo
+
+func (m *AdapterDriversReply) GetDrivers() []*AdapterDriver {
+	if m != nil {
+		return m.Drivers
+	}
+	return nil
+}
+
+type CheckAdapterRequest struct {
+	AdapterHandle        int32    `protobuf:"varint,1,opt,name=adapterHandle,proto3" json:"adapterHandle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckAdapterRequest) Reset()         { *m = CheckAdapterRequest{} }
+func (m *CheckAdapterRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckAdapterRequest) ProtoMessage()    {}
+func (*CheckAdapterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{7}
+}
+
+func (m *CheckAdapterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckAdapterRequest.Unmarshal(m, b)
+}
+func (m *CheckAdapterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckAdapterRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckAdapterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckAdapterRequest.Merge(m, src)
+}
+func (m *CheckAdapterRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckAdapterRequest.Size(m)
+}
+func (m *CheckAdapterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckAdapterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckAdapterRequest proto.InternalMessageInfo
+
+func (m *CheckAdapterRequest) GetAdapterHandle() int32 {
+	if m != nil {
+		return m.AdapterHandle
+	}
+	return 0
+}
+
+type CheckAdapterReply struct {
+	Healthy              bool     `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckAdapterReply) Reset()         { *m = CheckAdapterReply{} }
+func (m *CheckAdapterReply) String() string { return proto.CompactTextString(m) }
+func (*CheckAdapterReply) ProtoMessage()    {}
+func (*CheckAdapterReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{8}
+}
+
+func (m *CheckAdapterReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckAdapterReply.Unmarshal(m, b)
+}
+func (m *CheckAdapterReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckAdapterReply.Marshal(b, m, deterministic)
+}
+func (m *CheckAdapterReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckAdapterReply.Merge(m, src)
+}
+func (m *CheckAdapterReply) XXX_Size() int {
+	return xxx_messageInfo_CheckAdapterReply.Size(m)
+}
+func (m *CheckAdapterReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckAdapterReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckAdapterReply proto.InternalMessageInfo
+
+func (m *CheckAdapterReply) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *CheckAdapterReply) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 type EnforceRequest struct {
 	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
 	Params               []string `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty"`
@@ -212,7 +481,7 @@ func (m *EnforceRequest) Reset()         { *m = EnforceRequest{} }
 func (m *EnforceRequest) String() string { return proto.CompactTextString(m) }
 func (*EnforceRequest) ProtoMessage()    {}
 func (*EnforceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{4}
+	return fileDescriptor_f835afd114115d9e, []int{9}
 }
 
 func (m *EnforceRequest) XXX_Unmarshal(b []byte) error {
@@ -247,6 +516,148 @@ func (m *EnforceRequest) GetParams() []string {
 	return nil
 }
 
+type WhoCanRequest struct {
+	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	Obj                  string   `protobuf:"bytes,2,opt,name=obj,proto3" json:"obj,omitempty"`
+	Act                  string   `protobuf:"bytes,3,opt,name=act,proto3" json:"act,omitempty"`
+	Domain               string   `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WhoCanRequest) Reset()         { *m = WhoCanRequest{} }
+func (m *WhoCanRequest) String() string { return proto.CompactTextString(m) }
+func (*WhoCanRequest) ProtoMessage()    {}
+func (*WhoCanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{10}
+}
+
+func (m *WhoCanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WhoCanRequest.Unmarshal(m, b)
+}
+func (m *WhoCanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WhoCanRequest.Marshal(b, m, deterministic)
+}
+func (m *WhoCanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhoCanRequest.Merge(m, src)
+}
+func (m *WhoCanRequest) XXX_Size() int {
+	return xxx_messageInfo_WhoCanRequest.Size(m)
+}
+func (m *WhoCanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhoCanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhoCanRequest proto.InternalMessageInfo
+
+func (m *WhoCanRequest) GetEnforcerHandler() int32 {
+	if m != nil {
+		return m.EnforcerHandler
+	}
+	return 0
+}
+
+func (m *WhoCanRequest) GetObj() string {
+	if m != nil {
+		return m.Obj
+	}
+	return ""
+}
+
+func (m *WhoCanRequest) GetAct() string {
+	if m != nil {
+		return m.Act
+	}
+	return ""
+}
+
+func (m *WhoCanRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type ListAllowedRequest struct {
+	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Domain               string   `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	ObjectPrefix         string   `protobuf:"bytes,4,opt,name=objectPrefix,proto3" json:"objectPrefix,omitempty"`
+	PageSize             int32    `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken            string   `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAllowedRequest) Reset()         { *m = ListAllowedRequest{} }
+func (m *ListAllowedRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAllowedRequest) ProtoMessage()    {}
+func (*ListAllowedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{11}
+}
+
+func (m *ListAllowedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAllowedRequest.Unmarshal(m, b)
+}
+func (m *ListAllowedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAllowedRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAllowedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAllowedRequest.Merge(m, src)
+}
+func (m *ListAllowedRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAllowedRequest.Size(m)
+}
+func (m *ListAllowedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAllowedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAllowedRequest proto.InternalMessageInfo
+
+func (m *ListAllowedRequest) GetEnforcerHandler() int32 {
+	if m != nil {
+		return m.EnforcerHandler
+	}
+	return 0
+}
+
+func (m *ListAllowedRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ListAllowedRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ListAllowedRequest) GetObjectPrefix() string {
+	if m != nil {
+		return m.ObjectPrefix
+	}
+	return ""
+}
+
+func (m *ListAllowedRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListAllowedRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type BoolReply struct {
 	Res                  bool     `protobuf:"varint,1,opt,name=res,proto3" json:"res,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -258,7 +669,7 @@ func (m *BoolReply) Reset()         { *m = BoolReply{} }
 func (m *BoolReply) String() string { return proto.CompactTextString(m) }
 func (*BoolReply) ProtoMessage()    {}
 func (*BoolReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{5}
+	return fileDescriptor_f835afd114115d9e, []int{12}
 }
 
 func (m *BoolReply) XXX_Unmarshal(b []byte) error {
@@ -297,7 +708,7 @@ func (m *EmptyRequest) Reset()         { *m = EmptyRequest{} }
 func (m *EmptyRequest) String() string { return proto.CompactTextString(m) }
 func (*EmptyRequest) ProtoMessage()    {}
 func (*EmptyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{6}
+	return fileDescriptor_f835afd114115d9e, []int{13}
 }
 
 func (m *EmptyRequest) XXX_Unmarshal(b []byte) error {
@@ -325,17 +736,64 @@ func (m *EmptyRequest) GetHandler() int32 {
 	return 0
 }
 
-type EmptyReply struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListRequest struct {
+	Handler              int32        `protobuf:"varint,1,opt,name=handler,proto3" json:"handler,omitempty"`
+	Page                 *PageOptions `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *EmptyReply) Reset()         { *m = EmptyReply{} }
-func (m *EmptyReply) String() string { return proto.CompactTextString(m) }
-func (*EmptyReply) ProtoMessage()    {}
+func (m *ListRequest) Reset()         { *m = ListRequest{} }
+func (m *ListRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()    {}
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{14}
+}
+
+func (m *ListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
+}
+func (m *ListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRequest.Merge(m, src)
+}
+func (m *ListRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRequest.Size(m)
+}
+func (m *ListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRequest proto.InternalMessageInfo
+
+func (m *ListRequest) GetHandler() int32 {
+	if m != nil {
+		return m.Handler
+	}
+	return 0
+}
+
+func (m *ListRequest) GetPage() *PageOptions {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type EmptyReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmptyReply) Reset()         { *m = EmptyReply{} }
+func (m *EmptyReply) String() string { return proto.CompactTextString(m) }
+func (*EmptyReply) ProtoMessage()    {}
 func (*EmptyReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{7}
+	return fileDescriptor_f835afd114115d9e, []int{15}
 }
 
 func (m *EmptyReply) XXX_Unmarshal(b []byte) error {
@@ -356,20 +814,329 @@ func (m *EmptyReply) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EmptyReply proto.InternalMessageInfo
 
-type PolicyRequest struct {
+type PolicyFilter struct {
+	PType                string   `protobuf:"bytes,1,opt,name=pType,proto3" json:"pType,omitempty"`
+	FieldIndex           int32    `protobuf:"varint,2,opt,name=fieldIndex,proto3" json:"fieldIndex,omitempty"`
+	FieldValues          []string `protobuf:"bytes,3,rep,name=fieldValues,proto3" json:"fieldValues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolicyFilter) Reset()         { *m = PolicyFilter{} }
+func (m *PolicyFilter) String() string { return proto.CompactTextString(m) }
+func (*PolicyFilter) ProtoMessage()    {}
+func (*PolicyFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{16}
+}
+
+func (m *PolicyFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyFilter.Unmarshal(m, b)
+}
+func (m *PolicyFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyFilter.Marshal(b, m, deterministic)
+}
+func (m *PolicyFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyFilter.Merge(m, src)
+}
+func (m *PolicyFilter) XXX_Size() int {
+	return xxx_messageInfo_PolicyFilter.Size(m)
+}
+func (m *PolicyFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyFilter proto.InternalMessageInfo
+
+func (m *PolicyFilter) GetPType() string {
+	if m != nil {
+		return m.PType
+	}
+	return ""
+}
+
+func (m *PolicyFilter) GetFieldIndex() int32 {
+	if m != nil {
+		return m.FieldIndex
+	}
+	return 0
+}
+
+func (m *PolicyFilter) GetFieldValues() []string {
+	if m != nil {
+		return m.FieldValues
+	}
+	return nil
+}
+
+type LoadFilteredPolicyRequest struct {
+	EnforcerHandler      int32           `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	Filters              []*PolicyFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *LoadFilteredPolicyRequest) Reset()         { *m = LoadFilteredPolicyRequest{} }
+func (m *LoadFilteredPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadFilteredPolicyRequest) ProtoMessage()    {}
+func (*LoadFilteredPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{17}
+}
+
+func (m *LoadFilteredPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadFilteredPolicyRequest.Unmarshal(m, b)
+}
+func (m *LoadFilteredPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadFilteredPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadFilteredPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadFilteredPolicyRequest.Merge(m, src)
+}
+func (m *LoadFilteredPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadFilteredPolicyRequest.Size(m)
+}
+func (m *LoadFilteredPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadFilteredPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadFilteredPolicyRequest proto.InternalMessageInfo
+
+func (m *LoadFilteredPolicyRequest) GetEnforcerHandler() int32 {
+	if m != nil {
+		return m.EnforcerHandler
+	}
+	return 0
+}
+
+func (m *LoadFilteredPolicyRequest) GetFilters() []*PolicyFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+type ExportPolicyRequest struct {
 	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
-	PType                string   `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
-	Params               []string `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	Format               string   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportPolicyRequest) Reset()         { *m = ExportPolicyRequest{} }
+func (m *ExportPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportPolicyRequest) ProtoMessage()    {}
+func (*ExportPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{18}
+}
+
+func (m *ExportPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportPolicyRequest.Unmarshal(m, b)
+}
+func (m *ExportPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportPolicyRequest.Merge(m, src)
+}
+func (m *ExportPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportPolicyRequest.Size(m)
+}
+func (m *ExportPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportPolicyRequest proto.InternalMessageInfo
+
+func (m *ExportPolicyRequest) GetEnforcerHandler() int32 {
+	if m != nil {
+		return m.EnforcerHandler
+	}
+	return 0
+}
+
+func (m *ExportPolicyRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+type PolicyDataReply struct {
+	Format               string   `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolicyDataReply) Reset()         { *m = PolicyDataReply{} }
+func (m *PolicyDataReply) String() string { return proto.CompactTextString(m) }
+func (*PolicyDataReply) ProtoMessage()    {}
+func (*PolicyDataReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{19}
+}
+
+func (m *PolicyDataReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyDataReply.Unmarshal(m, b)
+}
+func (m *PolicyDataReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyDataReply.Marshal(b, m, deterministic)
+}
+func (m *PolicyDataReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyDataReply.Merge(m, src)
+}
+func (m *PolicyDataReply) XXX_Size() int {
+	return xxx_messageInfo_PolicyDataReply.Size(m)
+}
+func (m *PolicyDataReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyDataReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyDataReply proto.InternalMessageInfo
+
+func (m *PolicyDataReply) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *PolicyDataReply) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// ImportPolicyRequest loads rules in the csv, json or yaml format. mode is "merge" (the
+// default) to add the rules to the current policy, or "replace" to replace the whole policy.
+type ImportPolicyRequest struct {
+	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	Format               string   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Data                 string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Mode                 string   `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportPolicyRequest) Reset()         { *m = ImportPolicyRequest{} }
+func (m *ImportPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportPolicyRequest) ProtoMessage()    {}
+func (*ImportPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{20}
+}
+
+func (m *ImportPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportPolicyRequest.Unmarshal(m, b)
+}
+func (m *ImportPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportPolicyRequest.Merge(m, src)
+}
+func (m *ImportPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportPolicyRequest.Size(m)
+}
+func (m *ImportPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportPolicyRequest proto.InternalMessageInfo
+
+func (m *ImportPolicyRequest) GetEnforcerHandler() int32 {
+	if m != nil {
+		return m.EnforcerHandler
+	}
+	return 0
+}
+
+func (m *ImportPolicyRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ImportPolicyRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ImportPolicyRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+type ImportPolicyReply struct {
+	Added                int32    `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	Skipped              int32    `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *ImportPolicyReply) Reset()         { *m = ImportPolicyReply{} }
+func (m *ImportPolicyReply) String() string { return proto.CompactTextString(m) }
+func (*ImportPolicyReply) ProtoMessage()    {}
+func (*ImportPolicyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{21}
+}
+
+func (m *ImportPolicyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportPolicyReply.Unmarshal(m, b)
+}
+func (m *ImportPolicyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportPolicyReply.Marshal(b, m, deterministic)
+}
+func (m *ImportPolicyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportPolicyReply.Merge(m, src)
+}
+func (m *ImportPolicyReply) XXX_Size() int {
+	return xxx_messageInfo_ImportPolicyReply.Size(m)
+}
+func (m *ImportPolicyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportPolicyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportPolicyReply proto.InternalMessageInfo
+
+func (m *ImportPolicyReply) GetAdded() int32 {
+	if m != nil {
+		return m.Added
+	}
+	return 0
+}
+
+func (m *ImportPolicyReply) GetSkipped() int32 {
+	if m != nil {
+		return m.Skipped
+	}
+	return 0
+}
+
+type PolicyRequest struct {
+	EnforcerHandler      int32        `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	PType                string       `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+	Params               []string     `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	Page                 *PageOptions `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
 func (m *PolicyRequest) Reset()         { *m = PolicyRequest{} }
 func (m *PolicyRequest) String() string { return proto.CompactTextString(m) }
 func (*PolicyRequest) ProtoMessage()    {}
 func (*PolicyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{8}
+	return fileDescriptor_f835afd114115d9e, []int{22}
 }
 
 func (m *PolicyRequest) XXX_Unmarshal(b []byte) error {
@@ -396,356 +1163,1403 @@ func (m *PolicyRequest) GetEnforcerHandler() int32 {
 	}
 	return 0
 }
-
-func (m *PolicyRequest) GetPType() string {
-	if m != nil {
-		return m.PType
-	}
-	return ""
+
+func (m *PolicyRequest) GetPType() string {
+	if m != nil {
+		return m.PType
+	}
+	return ""
+}
+
+func (m *PolicyRequest) GetParams() []string {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *PolicyRequest) GetPage() *PageOptions {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type SimpleGetRequest struct {
+	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	PType                string   `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimpleGetRequest) Reset()         { *m = SimpleGetRequest{} }
+func (m *SimpleGetRequest) String() string { return proto.CompactTextString(m) }
+func (*SimpleGetRequest) ProtoMessage()    {}
+func (*SimpleGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{23}
+}
+
+func (m *SimpleGetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimpleGetRequest.Unmarshal(m, b)
+}
+func (m *SimpleGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimpleGetRequest.Marshal(b, m, deterministic)
+}
+func (m *SimpleGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimpleGetRequest.Merge(m, src)
+}
+func (m *SimpleGetRequest) XXX_Size() int {
+	return xxx_messageInfo_SimpleGetRequest.Size(m)
+}
+func (m *SimpleGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimpleGetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimpleGetRequest proto.InternalMessageInfo
+
+func (m *SimpleGetRequest) GetEnforcerHandler() int32 {
+	if m != nil {
+		return m.EnforcerHandler
+	}
+	return 0
+}
+
+func (m *SimpleGetRequest) GetPType() string {
+	if m != nil {
+		return m.PType
+	}
+	return ""
+}
+
+type ArrayReply struct {
+	Array                []string `protobuf:"bytes,1,rep,name=array,proto3" json:"array,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArrayReply) Reset()         { *m = ArrayReply{} }
+func (m *ArrayReply) String() string { return proto.CompactTextString(m) }
+func (*ArrayReply) ProtoMessage()    {}
+func (*ArrayReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{24}
+}
+
+func (m *ArrayReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArrayReply.Unmarshal(m, b)
+}
+func (m *ArrayReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArrayReply.Marshal(b, m, deterministic)
+}
+func (m *ArrayReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrayReply.Merge(m, src)
+}
+func (m *ArrayReply) XXX_Size() int {
+	return xxx_messageInfo_ArrayReply.Size(m)
+}
+func (m *ArrayReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrayReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArrayReply proto.InternalMessageInfo
+
+func (m *ArrayReply) GetArray() []string {
+	if m != nil {
+		return m.Array
+	}
+	return nil
+}
+
+type FilteredPolicyRequest struct {
+	EnforcerHandler      int32        `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	PType                string       `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+	FieldIndex           int32        `protobuf:"varint,3,opt,name=fieldIndex,proto3" json:"fieldIndex,omitempty"`
+	FieldValues          []string     `protobuf:"bytes,4,rep,name=fieldValues,proto3" json:"fieldValues,omitempty"`
+	Page                 *PageOptions `protobuf:"bytes,5,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FilteredPolicyRequest) Reset()         { *m = FilteredPolicyRequest{} }
+func (m *FilteredPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*FilteredPolicyRequest) ProtoMessage()    {}
+func (*FilteredPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{25}
+}
+
+func (m *FilteredPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilteredPolicyRequest.Unmarshal(m, b)
+}
+func (m *FilteredPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilteredPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *FilteredPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilteredPolicyRequest.Merge(m, src)
+}
+func (m *FilteredPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_FilteredPolicyRequest.Size(m)
+}
+func (m *FilteredPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilteredPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilteredPolicyRequest proto.InternalMessageInfo
+
+func (m *FilteredPolicyRequest) GetEnforcerHandler() int32 {
+	if m != nil {
+		return m.EnforcerHandler
+	}
+	return 0
+}
+
+func (m *FilteredPolicyRequest) GetPType() string {
+	if m != nil {
+		return m.PType
+	}
+	return ""
+}
+
+func (m *FilteredPolicyRequest) GetFieldIndex() int32 {
+	if m != nil {
+		return m.FieldIndex
+	}
+	return 0
+}
+
+func (m *FilteredPolicyRequest) GetFieldValues() []string {
+	if m != nil {
+		return m.FieldValues
+	}
+	return nil
+}
+
+func (m *FilteredPolicyRequest) GetPage() *PageOptions {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+// PageOptions pages the rules returned by the GetPolicy family. The rules are sorted by
+// sortFieldIndex, and only the ones with a field matching search are kept. searchField is
+// the 1-based position of the field to search, 0 (the default) searches any field.
+// searchMode is one of "substring" (the default), "prefix" or "regex".
+type PageOptions struct {
+	PageSize             int32    `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken            string   `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortFieldIndex       int32    `protobuf:"varint,3,opt,name=sortFieldIndex,proto3" json:"sortFieldIndex,omitempty"`
+	SortDescending       bool     `protobuf:"varint,4,opt,name=sortDescending,proto3" json:"sortDescending,omitempty"`
+	Search               string   `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"`
+	SearchMode           string   `protobuf:"bytes,7,opt,name=searchMode,proto3" json:"searchMode,omitempty"`
+	SearchField          int32    `protobuf:"varint,8,opt,name=searchField,proto3" json:"searchField,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PageOptions) Reset()         { *m = PageOptions{} }
+func (m *PageOptions) String() string { return proto.CompactTextString(m) }
+func (*PageOptions) ProtoMessage()    {}
+func (*PageOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{26}
+}
+
+func (m *PageOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PageOptions.Unmarshal(m, b)
+}
+func (m *PageOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PageOptions.Marshal(b, m, deterministic)
+}
+func (m *PageOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PageOptions.Merge(m, src)
+}
+func (m *PageOptions) XXX_Size() int {
+	return xxx_messageInfo_PageOptions.Size(m)
+}
+func (m *PageOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_PageOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PageOptions proto.InternalMessageInfo
+
+func (m *PageOptions) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *PageOptions) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *PageOptions) GetSortFieldIndex() int32 {
+	if m != nil {
+		return m.SortFieldIndex
+	}
+	return 0
+}
+
+func (m *PageOptions) GetSortDescending() bool {
+	if m != nil {
+		return m.SortDescending
+	}
+	return false
+}
+
+func (m *PageOptions) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *PageOptions) GetSearchMode() string {
+	if m != nil {
+		return m.SearchMode
+	}
+	return ""
+}
+
+func (m *PageOptions) GetSearchField() int32 {
+	if m != nil {
+		return m.SearchField
+	}
+	return 0
+}
+
+type UserRoleRequest struct {
+	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	User                 string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Domain               string   `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	PType                string   `protobuf:"bytes,5,opt,name=pType,proto3" json:"pType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserRoleRequest) Reset()         { *m = UserRoleRequest{} }
+func (m *UserRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*UserRoleRequest) ProtoMessage()    {}
+func (*UserRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{27}
+}
+
+func (m *UserRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserRoleRequest.Unmarshal(m, b)
+}
+func (m *UserRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *UserRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserRoleRequest.Merge(m, src)
+}
+func (m *UserRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_UserRoleRequest.Size(m)
+}
+func (m *UserRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserRoleRequest proto.InternalMessageInfo
+
+func (m *UserRoleRequest) GetEnforcerHandler() int32 {
+	if m != nil {
+		return m.EnforcerHandler
+	}
+	return 0
+}
+
+func (m *UserRoleRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *UserRoleRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *UserRoleRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *UserRoleRequest) GetPType() string {
+	if m != nil {
+		return m.PType
+	}
+	return ""
+}
+
+type PermissionRequest struct {
+	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	User                 string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Permissions          []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Domain               string   `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	PType                string   `protobuf:"bytes,5,opt,name=pType,proto3" json:"pType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PermissionRequest) Reset()         { *m = PermissionRequest{} }
+func (m *PermissionRequest) String() string { return proto.CompactTextString(m) }
+func (*PermissionRequest) ProtoMessage()    {}
+func (*PermissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{28}
+}
+
+func (m *PermissionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PermissionRequest.Unmarshal(m, b)
+}
+func (m *PermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PermissionRequest.Marshal(b, m, deterministic)
+}
+func (m *PermissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionRequest.Merge(m, src)
+}
+func (m *PermissionRequest) XXX_Size() int {
+	return xxx_messageInfo_PermissionRequest.Size(m)
+}
+func (m *PermissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionRequest proto.InternalMessageInfo
+
+func (m *PermissionRequest) GetEnforcerHandler() int32 {
+	if m != nil {
+		return m.EnforcerHandler
+	}
+	return 0
+}
+
+func (m *PermissionRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *PermissionRequest) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *PermissionRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *PermissionRequest) GetPType() string {
+	if m != nil {
+		return m.PType
+	}
+	return ""
+}
+
+type Array2DReply struct {
+	D2                   []*Array2DReplyD `protobuf:"bytes,1,rep,name=d2,proto3" json:"d2,omitempty"`
+	NextPageToken        string           `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	Total                int32            `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Array2DReply) Reset()         { *m = Array2DReply{} }
+func (m *Array2DReply) String() string { return proto.CompactTextString(m) }
+func (*Array2DReply) ProtoMessage()    {}
+func (*Array2DReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{29}
+}
+
+func (m *Array2DReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Array2DReply.Unmarshal(m, b)
+}
+func (m *Array2DReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Array2DReply.Marshal(b, m, deterministic)
+}
+func (m *Array2DReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Array2DReply.Merge(m, src)
+}
+func (m *Array2DReply) XXX_Size() int {
+	return xxx_messageInfo_Array2DReply.Size(m)
+}
+func (m *Array2DReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_Array2DReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Array2DReply proto.InternalMessageInfo
+
+func (m *Array2DReply) GetD2() []*Array2DReplyD {
+	if m != nil {
+		return m.D2
+	}
+	return nil
+}
+
+func (m *Array2DReply) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *Array2DReply) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type Array2DReplyD struct {
+	D1                   []string `protobuf:"bytes,1,rep,name=d1,proto3" json:"d1,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Array2DReplyD) Reset()         { *m = Array2DReplyD{} }
+func (m *Array2DReplyD) String() string { return proto.CompactTextString(m) }
+func (*Array2DReplyD) ProtoMessage()    {}
+func (*Array2DReplyD) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{29, 0}
+}
+
+func (m *Array2DReplyD) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Array2DReplyD.Unmarshal(m, b)
+}
+func (m *Array2DReplyD) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Array2DReplyD.Marshal(b, m, deterministic)
+}
+func (m *Array2DReplyD) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Array2DReplyD.Merge(m, src)
+}
+func (m *Array2DReplyD) XXX_Size() int {
+	return xxx_messageInfo_Array2DReplyD.Size(m)
+}
+func (m *Array2DReplyD) XXX_DiscardUnknown() {
+	xxx_messageInfo_Array2DReplyD.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Array2DReplyD proto.InternalMessageInfo
+
+func (m *Array2DReplyD) GetD1() []string {
+	if m != nil {
+		return m.D1
+	}
+	return nil
+}
+
+type InheritedRule struct {
+	Rule                 []string `protobuf:"bytes,1,rep,name=rule,proto3" json:"rule,omitempty"`
+	Path                 []string `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	ObjectPath           []string `protobuf:"bytes,3,rep,name=objectPath,proto3" json:"objectPath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InheritedRule) Reset()         { *m = InheritedRule{} }
+func (m *InheritedRule) String() string { return proto.CompactTextString(m) }
+func (*InheritedRule) ProtoMessage()    {}
+func (*InheritedRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{30}
+}
+
+func (m *InheritedRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InheritedRule.Unmarshal(m, b)
+}
+func (m *InheritedRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InheritedRule.Marshal(b, m, deterministic)
+}
+func (m *InheritedRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InheritedRule.Merge(m, src)
+}
+func (m *InheritedRule) XXX_Size() int {
+	return xxx_messageInfo_InheritedRule.Size(m)
+}
+func (m *InheritedRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_InheritedRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InheritedRule proto.InternalMessageInfo
+
+func (m *InheritedRule) GetRule() []string {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (m *InheritedRule) GetPath() []string {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *InheritedRule) GetObjectPath() []string {
+	if m != nil {
+		return m.ObjectPath
+	}
+	return nil
+}
+
+type InheritedReply struct {
+	Items                []*InheritedRule `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *InheritedReply) Reset()         { *m = InheritedReply{} }
+func (m *InheritedReply) String() string { return proto.CompactTextString(m) }
+func (*InheritedReply) ProtoMessage()    {}
+func (*InheritedReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{31}
+}
+
+func (m *InheritedReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InheritedReply.Unmarshal(m, b)
+}
+func (m *InheritedReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InheritedReply.Marshal(b, m, deterministic)
+}
+func (m *InheritedReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InheritedReply.Merge(m, src)
+}
+func (m *InheritedReply) XXX_Size() int {
+	return xxx_messageInfo_InheritedReply.Size(m)
+}
+func (m *InheritedReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_InheritedReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InheritedReply proto.InternalMessageInfo
+
+func (m *InheritedReply) GetItems() []*InheritedRule {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type RoleGraphRequest struct {
+	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	PType                string   `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+	Domain               string   `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	Format               string   `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	IncludePermissions   bool     `protobuf:"varint,5,opt,name=includePermissions,proto3" json:"includePermissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleGraphRequest) Reset()         { *m = RoleGraphRequest{} }
+func (m *RoleGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*RoleGraphRequest) ProtoMessage()    {}
+func (*RoleGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{32}
+}
+
+func (m *RoleGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleGraphRequest.Unmarshal(m, b)
+}
+func (m *RoleGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleGraphRequest.Marshal(b, m, deterministic)
+}
+func (m *RoleGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleGraphRequest.Merge(m, src)
+}
+func (m *RoleGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_RoleGraphRequest.Size(m)
+}
+func (m *RoleGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleGraphRequest proto.InternalMessageInfo
+
+func (m *RoleGraphRequest) GetEnforcerHandler() int32 {
+	if m != nil {
+		return m.EnforcerHandler
+	}
+	return 0
+}
+
+func (m *RoleGraphRequest) GetPType() string {
+	if m != nil {
+		return m.PType
+	}
+	return ""
+}
+
+func (m *RoleGraphRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *RoleGraphRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *RoleGraphRequest) GetIncludePermissions() bool {
+	if m != nil {
+		return m.IncludePermissions
+	}
+	return false
+}
+
+type RoleGraphReply struct {
+	Format               string   `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleGraphReply) Reset()         { *m = RoleGraphReply{} }
+func (m *RoleGraphReply) String() string { return proto.CompactTextString(m) }
+func (*RoleGraphReply) ProtoMessage()    {}
+func (*RoleGraphReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{33}
+}
+
+func (m *RoleGraphReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleGraphReply.Unmarshal(m, b)
+}
+func (m *RoleGraphReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleGraphReply.Marshal(b, m, deterministic)
+}
+func (m *RoleGraphReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleGraphReply.Merge(m, src)
+}
+func (m *RoleGraphReply) XXX_Size() int {
+	return xxx_messageInfo_RoleGraphReply.Size(m)
+}
+func (m *RoleGraphReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleGraphReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleGraphReply proto.InternalMessageInfo
+
+func (m *RoleGraphReply) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *RoleGraphReply) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type RolePath struct {
+	Path                 []string `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RolePath) Reset()         { *m = RolePath{} }
+func (m *RolePath) String() string { return proto.CompactTextString(m) }
+func (*RolePath) ProtoMessage()    {}
+func (*RolePath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{34}
+}
+
+func (m *RolePath) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RolePath.Unmarshal(m, b)
+}
+func (m *RolePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RolePath.Marshal(b, m, deterministic)
+}
+func (m *RolePath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolePath.Merge(m, src)
+}
+func (m *RolePath) XXX_Size() int {
+	return xxx_messageInfo_RolePath.Size(m)
+}
+func (m *RolePath) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolePath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolePath proto.InternalMessageInfo
+
+func (m *RolePath) GetPath() []string {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+type CheckRoleGraphReply struct {
+	Cycles               []*RolePath `protobuf:"bytes,1,rep,name=cycles,proto3" json:"cycles,omitempty"`
+	OrphanRoles          []string    `protobuf:"bytes,2,rep,name=orphanRoles,proto3" json:"orphanRoles,omitempty"`
+	DeepChains           []*RolePath `protobuf:"bytes,3,rep,name=deepChains,proto3" json:"deepChains,omitempty"`
+	MaxDepth             int32       `protobuf:"varint,4,opt,name=maxDepth,proto3" json:"maxDepth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *CheckRoleGraphReply) Reset()         { *m = CheckRoleGraphReply{} }
+func (m *CheckRoleGraphReply) String() string { return proto.CompactTextString(m) }
+func (*CheckRoleGraphReply) ProtoMessage()    {}
+func (*CheckRoleGraphReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{35}
+}
+
+func (m *CheckRoleGraphReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckRoleGraphReply.Unmarshal(m, b)
+}
+func (m *CheckRoleGraphReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckRoleGraphReply.Marshal(b, m, deterministic)
+}
+func (m *CheckRoleGraphReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckRoleGraphReply.Merge(m, src)
+}
+func (m *CheckRoleGraphReply) XXX_Size() int {
+	return xxx_messageInfo_CheckRoleGraphReply.Size(m)
+}
+func (m *CheckRoleGraphReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckRoleGraphReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckRoleGraphReply proto.InternalMessageInfo
+
+func (m *CheckRoleGraphReply) GetCycles() []*RolePath {
+	if m != nil {
+		return m.Cycles
+	}
+	return nil
+}
+
+func (m *CheckRoleGraphReply) GetOrphanRoles() []string {
+	if m != nil {
+		return m.OrphanRoles
+	}
+	return nil
+}
+
+func (m *CheckRoleGraphReply) GetDeepChains() []*RolePath {
+	if m != nil {
+		return m.DeepChains
+	}
+	return nil
+}
+
+func (m *CheckRoleGraphReply) GetMaxDepth() int32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+type PolicyFinding struct {
+	Kind                 string           `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Message              string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PType                string           `protobuf:"bytes,3,opt,name=pType,proto3" json:"pType,omitempty"`
+	Rules                []*Array2DReplyD `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PolicyFinding) Reset()         { *m = PolicyFinding{} }
+func (m *PolicyFinding) String() string { return proto.CompactTextString(m) }
+func (*PolicyFinding) ProtoMessage()    {}
+func (*PolicyFinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{36}
+}
+
+func (m *PolicyFinding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyFinding.Unmarshal(m, b)
+}
+func (m *PolicyFinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyFinding.Marshal(b, m, deterministic)
+}
+func (m *PolicyFinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyFinding.Merge(m, src)
+}
+func (m *PolicyFinding) XXX_Size() int {
+	return xxx_messageInfo_PolicyFinding.Size(m)
+}
+func (m *PolicyFinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyFinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyFinding proto.InternalMessageInfo
+
+func (m *PolicyFinding) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *PolicyFinding) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *PolicyFinding) GetPType() string {
+	if m != nil {
+		return m.PType
+	}
+	return ""
+}
+
+func (m *PolicyFinding) GetRules() []*Array2DReplyD {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type AnalyzePolicyReply struct {
+	Findings             []*PolicyFinding `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AnalyzePolicyReply) Reset()         { *m = AnalyzePolicyReply{} }
+func (m *AnalyzePolicyReply) String() string { return proto.CompactTextString(m) }
+func (*AnalyzePolicyReply) ProtoMessage()    {}
+func (*AnalyzePolicyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{37}
+}
+
+func (m *AnalyzePolicyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnalyzePolicyReply.Unmarshal(m, b)
+}
+func (m *AnalyzePolicyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnalyzePolicyReply.Marshal(b, m, deterministic)
+}
+func (m *AnalyzePolicyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyzePolicyReply.Merge(m, src)
+}
+func (m *AnalyzePolicyReply) XXX_Size() int {
+	return xxx_messageInfo_AnalyzePolicyReply.Size(m)
+}
+func (m *AnalyzePolicyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyzePolicyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalyzePolicyReply proto.InternalMessageInfo
+
+func (m *AnalyzePolicyReply) GetFindings() []*PolicyFinding {
+	if m != nil {
+		return m.Findings
+	}
+	return nil
+}
+
+type ModelTemplate struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ModelText            string   `protobuf:"bytes,3,opt,name=modelText,proto3" json:"modelText,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModelTemplate) Reset()         { *m = ModelTemplate{} }
+func (m *ModelTemplate) String() string { return proto.CompactTextString(m) }
+func (*ModelTemplate) ProtoMessage()    {}
+func (*ModelTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{38}
+}
+
+func (m *ModelTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModelTemplate.Unmarshal(m, b)
+}
+func (m *ModelTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModelTemplate.Marshal(b, m, deterministic)
+}
+func (m *ModelTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModelTemplate.Merge(m, src)
+}
+func (m *ModelTemplate) XXX_Size() int {
+	return xxx_messageInfo_ModelTemplate.Size(m)
+}
+func (m *ModelTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModelTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModelTemplate proto.InternalMessageInfo
+
+func (m *ModelTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ModelTemplate) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ModelTemplate) GetModelText() string {
+	if m != nil {
+		return m.ModelText
+	}
+	return ""
+}
+
+type ModelTemplatesReply struct {
+	Templates            []*ModelTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ModelTemplatesReply) Reset()         { *m = ModelTemplatesReply{} }
+func (m *ModelTemplatesReply) String() string { return proto.CompactTextString(m) }
+func (*ModelTemplatesReply) ProtoMessage()    {}
+func (*ModelTemplatesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{39}
+}
+
+func (m *ModelTemplatesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModelTemplatesReply.Unmarshal(m, b)
+}
+func (m *ModelTemplatesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModelTemplatesReply.Marshal(b, m, deterministic)
+}
+func (m *ModelTemplatesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModelTemplatesReply.Merge(m, src)
+}
+func (m *ModelTemplatesReply) XXX_Size() int {
+	return xxx_messageInfo_ModelTemplatesReply.Size(m)
+}
+func (m *ModelTemplatesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModelTemplatesReply.DiscardUnknown(m)
 }
 
-func (m *PolicyRequest) GetParams() []string {
+var xxx_messageInfo_ModelTemplatesReply proto.InternalMessageInfo
+
+func (m *ModelTemplatesReply) GetTemplates() []*ModelTemplate {
 	if m != nil {
-		return m.Params
+		return m.Templates
 	}
 	return nil
 }
 
-type SimpleGetRequest struct {
-	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
-	PType                string   `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+type VersionReply struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit               string   `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	BuildDate            string   `protobuf:"bytes,3,opt,name=buildDate,proto3" json:"buildDate,omitempty"`
+	GoVersion            string   `protobuf:"bytes,4,opt,name=goVersion,proto3" json:"goVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SimpleGetRequest) Reset()         { *m = SimpleGetRequest{} }
-func (m *SimpleGetRequest) String() string { return proto.CompactTextString(m) }
-func (*SimpleGetRequest) ProtoMessage()    {}
-func (*SimpleGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{9}
+func (m *VersionReply) Reset()         { *m = VersionReply{} }
+func (m *VersionReply) String() string { return proto.CompactTextString(m) }
+func (*VersionReply) ProtoMessage()    {}
+func (*VersionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{40}
 }
 
-func (m *SimpleGetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SimpleGetRequest.Unmarshal(m, b)
+func (m *VersionReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionReply.Unmarshal(m, b)
 }
-func (m *SimpleGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SimpleGetRequest.Marshal(b, m, deterministic)
+func (m *VersionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionReply.Marshal(b, m, deterministic)
 }
-func (m *SimpleGetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SimpleGetRequest.Merge(m, src)
+func (m *VersionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionReply.Merge(m, src)
 }
-func (m *SimpleGetRequest) XXX_Size() int {
-	return xxx_messageInfo_SimpleGetRequest.Size(m)
+func (m *VersionReply) XXX_Size() int {
+	return xxx_messageInfo_VersionReply.Size(m)
 }
-func (m *SimpleGetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SimpleGetRequest.DiscardUnknown(m)
+func (m *VersionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SimpleGetRequest proto.InternalMessageInfo
+var xxx_messageInfo_VersionReply proto.InternalMessageInfo
 
-func (m *SimpleGetRequest) GetEnforcerHandler() int32 {
+func (m *VersionReply) GetVersion() string {
 	if m != nil {
-		return m.EnforcerHandler
+		return m.Version
 	}
-	return 0
+	return ""
 }
 
-func (m *SimpleGetRequest) GetPType() string {
+func (m *VersionReply) GetCommit() string {
 	if m != nil {
-		return m.PType
+		return m.Commit
 	}
 	return ""
 }
 
-type ArrayReply struct {
-	Array                []string `protobuf:"bytes,1,rep,name=array,proto3" json:"array,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *ArrayReply) Reset()         { *m = ArrayReply{} }
-func (m *ArrayReply) String() string { return proto.CompactTextString(m) }
-func (*ArrayReply) ProtoMessage()    {}
-func (*ArrayReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{10}
-}
-
-func (m *ArrayReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ArrayReply.Unmarshal(m, b)
-}
-func (m *ArrayReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ArrayReply.Marshal(b, m, deterministic)
-}
-func (m *ArrayReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ArrayReply.Merge(m, src)
-}
-func (m *ArrayReply) XXX_Size() int {
-	return xxx_messageInfo_ArrayReply.Size(m)
-}
-func (m *ArrayReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ArrayReply.DiscardUnknown(m)
+func (m *VersionReply) GetBuildDate() string {
+	if m != nil {
+		return m.BuildDate
+	}
+	return ""
 }
 
-var xxx_messageInfo_ArrayReply proto.InternalMessageInfo
-
-func (m *ArrayReply) GetArray() []string {
+func (m *VersionReply) GetGoVersion() string {
 	if m != nil {
-		return m.Array
+		return m.GoVersion
 	}
-	return nil
+	return ""
 }
 
-type FilteredPolicyRequest struct {
-	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
-	PType                string   `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
-	FieldIndex           int32    `protobuf:"varint,3,opt,name=fieldIndex,proto3" json:"fieldIndex,omitempty"`
-	FieldValues          []string `protobuf:"bytes,4,rep,name=fieldValues,proto3" json:"fieldValues,omitempty"`
+// EnforcerStatus reports whether the policy of an enforcer was loaded from its adapter,
+// and how many p and g rules it holds.
+type EnforcerStatus struct {
+	Tenant               string   `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Handle               int32    `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Loaded               bool     `protobuf:"varint,3,opt,name=loaded,proto3" json:"loaded,omitempty"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Filtered             bool     `protobuf:"varint,5,opt,name=filtered,proto3" json:"filtered,omitempty"`
+	PolicySize           int32    `protobuf:"varint,6,opt,name=policySize,proto3" json:"policySize,omitempty"`
+	GroupingPolicySize   int32    `protobuf:"varint,7,opt,name=groupingPolicySize,proto3" json:"groupingPolicySize,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FilteredPolicyRequest) Reset()         { *m = FilteredPolicyRequest{} }
-func (m *FilteredPolicyRequest) String() string { return proto.CompactTextString(m) }
-func (*FilteredPolicyRequest) ProtoMessage()    {}
-func (*FilteredPolicyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{11}
+func (m *EnforcerStatus) Reset()         { *m = EnforcerStatus{} }
+func (m *EnforcerStatus) String() string { return proto.CompactTextString(m) }
+func (*EnforcerStatus) ProtoMessage()    {}
+func (*EnforcerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{41}
 }
 
-func (m *FilteredPolicyRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FilteredPolicyRequest.Unmarshal(m, b)
+func (m *EnforcerStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnforcerStatus.Unmarshal(m, b)
 }
-func (m *FilteredPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FilteredPolicyRequest.Marshal(b, m, deterministic)
+func (m *EnforcerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnforcerStatus.Marshal(b, m, deterministic)
 }
-func (m *FilteredPolicyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FilteredPolicyRequest.Merge(m, src)
+func (m *EnforcerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnforcerStatus.Merge(m, src)
 }
-func (m *FilteredPolicyRequest) XXX_Size() int {
-	return xxx_messageInfo_FilteredPolicyRequest.Size(m)
+func (m *EnforcerStatus) XXX_Size() int {
+	return xxx_messageInfo_EnforcerStatus.Size(m)
 }
-func (m *FilteredPolicyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FilteredPolicyRequest.DiscardUnknown(m)
+func (m *EnforcerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnforcerStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FilteredPolicyRequest proto.InternalMessageInfo
+var xxx_messageInfo_EnforcerStatus proto.InternalMessageInfo
 
-func (m *FilteredPolicyRequest) GetEnforcerHandler() int32 {
+func (m *EnforcerStatus) GetTenant() string {
 	if m != nil {
-		return m.EnforcerHandler
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *EnforcerStatus) GetHandle() int32 {
+	if m != nil {
+		return m.Handle
 	}
 	return 0
 }
 
-func (m *FilteredPolicyRequest) GetPType() string {
+func (m *EnforcerStatus) GetLoaded() bool {
 	if m != nil {
-		return m.PType
+		return m.Loaded
+	}
+	return false
+}
+
+func (m *EnforcerStatus) GetError() string {
+	if m != nil {
+		return m.Error
 	}
 	return ""
 }
 
-func (m *FilteredPolicyRequest) GetFieldIndex() int32 {
+func (m *EnforcerStatus) GetFiltered() bool {
 	if m != nil {
-		return m.FieldIndex
+		return m.Filtered
+	}
+	return false
+}
+
+func (m *EnforcerStatus) GetPolicySize() int32 {
+	if m != nil {
+		return m.PolicySize
 	}
 	return 0
 }
 
-func (m *FilteredPolicyRequest) GetFieldValues() []string {
+func (m *EnforcerStatus) GetGroupingPolicySize() int32 {
 	if m != nil {
-		return m.FieldValues
+		return m.GroupingPolicySize
 	}
-	return nil
+	return 0
 }
 
-type UserRoleRequest struct {
-	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
-	User                 string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+type AdapterStatus struct {
+	Tenant               string   `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Handle               int32    `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Healthy              bool     `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UserRoleRequest) Reset()         { *m = UserRoleRequest{} }
-func (m *UserRoleRequest) String() string { return proto.CompactTextString(m) }
-func (*UserRoleRequest) ProtoMessage()    {}
-func (*UserRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{12}
+func (m *AdapterStatus) Reset()         { *m = AdapterStatus{} }
+func (m *AdapterStatus) String() string { return proto.CompactTextString(m) }
+func (*AdapterStatus) ProtoMessage()    {}
+func (*AdapterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{42}
 }
 
-func (m *UserRoleRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UserRoleRequest.Unmarshal(m, b)
+func (m *AdapterStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdapterStatus.Unmarshal(m, b)
 }
-func (m *UserRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UserRoleRequest.Marshal(b, m, deterministic)
+func (m *AdapterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdapterStatus.Marshal(b, m, deterministic)
 }
-func (m *UserRoleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserRoleRequest.Merge(m, src)
+func (m *AdapterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdapterStatus.Merge(m, src)
 }
-func (m *UserRoleRequest) XXX_Size() int {
-	return xxx_messageInfo_UserRoleRequest.Size(m)
+func (m *AdapterStatus) XXX_Size() int {
+	return xxx_messageInfo_AdapterStatus.Size(m)
 }
-func (m *UserRoleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserRoleRequest.DiscardUnknown(m)
+func (m *AdapterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdapterStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserRoleRequest proto.InternalMessageInfo
+var xxx_messageInfo_AdapterStatus proto.InternalMessageInfo
 
-func (m *UserRoleRequest) GetEnforcerHandler() int32 {
+func (m *AdapterStatus) GetTenant() string {
 	if m != nil {
-		return m.EnforcerHandler
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *AdapterStatus) GetHandle() int32 {
+	if m != nil {
+		return m.Handle
 	}
 	return 0
 }
 
-func (m *UserRoleRequest) GetUser() string {
+func (m *AdapterStatus) GetHealthy() bool {
 	if m != nil {
-		return m.User
+		return m.Healthy
 	}
-	return ""
+	return false
 }
 
-func (m *UserRoleRequest) GetRole() string {
+func (m *AdapterStatus) GetMessage() string {
 	if m != nil {
-		return m.Role
+		return m.Message
 	}
 	return ""
 }
 
-type PermissionRequest struct {
-	EnforcerHandler      int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
-	User                 string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Permissions          []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// HealthReply reports the state of the enforcers and adapters. status is "ok", or
+// "degraded" when a policy failed to load or an adapter is unhealthy.
+type HealthReply struct {
+	Status               string            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Version              *VersionReply     `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Enforcers            []*EnforcerStatus `protobuf:"bytes,3,rep,name=enforcers,proto3" json:"enforcers,omitempty"`
+	Adapters             []*AdapterStatus  `protobuf:"bytes,4,rep,name=adapters,proto3" json:"adapters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *PermissionRequest) Reset()         { *m = PermissionRequest{} }
-func (m *PermissionRequest) String() string { return proto.CompactTextString(m) }
-func (*PermissionRequest) ProtoMessage()    {}
-func (*PermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{13}
+func (m *HealthReply) Reset()         { *m = HealthReply{} }
+func (m *HealthReply) String() string { return proto.CompactTextString(m) }
+func (*HealthReply) ProtoMessage()    {}
+func (*HealthReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{43}
 }
 
-func (m *PermissionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PermissionRequest.Unmarshal(m, b)
+func (m *HealthReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthReply.Unmarshal(m, b)
 }
-func (m *PermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PermissionRequest.Marshal(b, m, deterministic)
+func (m *HealthReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthReply.Marshal(b, m, deterministic)
 }
-func (m *PermissionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PermissionRequest.Merge(m, src)
+func (m *HealthReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthReply.Merge(m, src)
 }
-func (m *PermissionRequest) XXX_Size() int {
-	return xxx_messageInfo_PermissionRequest.Size(m)
+func (m *HealthReply) XXX_Size() int {
+	return xxx_messageInfo_HealthReply.Size(m)
 }
-func (m *PermissionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PermissionRequest.DiscardUnknown(m)
+func (m *HealthReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PermissionRequest proto.InternalMessageInfo
+var xxx_messageInfo_HealthReply proto.InternalMessageInfo
 
-func (m *PermissionRequest) GetEnforcerHandler() int32 {
+func (m *HealthReply) GetStatus() string {
 	if m != nil {
-		return m.EnforcerHandler
+		return m.Status
 	}
-	return 0
+	return ""
 }
 
-func (m *PermissionRequest) GetUser() string {
+func (m *HealthReply) GetVersion() *VersionReply {
 	if m != nil {
-		return m.User
+		return m.Version
 	}
-	return ""
+	return nil
 }
 
-func (m *PermissionRequest) GetPermissions() []string {
+func (m *HealthReply) GetEnforcers() []*EnforcerStatus {
 	if m != nil {
-		return m.Permissions
+		return m.Enforcers
 	}
 	return nil
 }
 
-type Array2DReply struct {
-	D2                   []*Array2DReplyD `protobuf:"bytes,1,rep,name=d2,proto3" json:"d2,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *HealthReply) GetAdapters() []*AdapterStatus {
+	if m != nil {
+		return m.Adapters
+	}
+	return nil
 }
 
-func (m *Array2DReply) Reset()         { *m = Array2DReply{} }
-func (m *Array2DReply) String() string { return proto.CompactTextString(m) }
-func (*Array2DReply) ProtoMessage()    {}
-func (*Array2DReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{14}
+type ReadinessReply struct {
+	Ready                bool     `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	Reasons              []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Array2DReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Array2DReply.Unmarshal(m, b)
+func (m *ReadinessReply) Reset()         { *m = ReadinessReply{} }
+func (m *ReadinessReply) String() string { return proto.CompactTextString(m) }
+func (*ReadinessReply) ProtoMessage()    {}
+func (*ReadinessReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{44}
 }
-func (m *Array2DReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Array2DReply.Marshal(b, m, deterministic)
+
+func (m *ReadinessReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadinessReply.Unmarshal(m, b)
 }
-func (m *Array2DReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Array2DReply.Merge(m, src)
+func (m *ReadinessReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadinessReply.Marshal(b, m, deterministic)
 }
-func (m *Array2DReply) XXX_Size() int {
-	return xxx_messageInfo_Array2DReply.Size(m)
+func (m *ReadinessReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadinessReply.Merge(m, src)
 }
-func (m *Array2DReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_Array2DReply.DiscardUnknown(m)
+func (m *ReadinessReply) XXX_Size() int {
+	return xxx_messageInfo_ReadinessReply.Size(m)
+}
+func (m *ReadinessReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadinessReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Array2DReply proto.InternalMessageInfo
+var xxx_messageInfo_ReadinessReply proto.InternalMessageInfo
 
-func (m *Array2DReply) GetD2() []*Array2DReplyD {
+func (m *ReadinessReply) GetReady() bool {
 	if m != nil {
-		return m.D2
+		return m.Ready
+	}
+	return false
+}
+
+func (m *ReadinessReply) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
 	}
 	return nil
 }
 
-type Array2DReplyD struct {
-	D1                   []string `protobuf:"bytes,1,rep,name=d1,proto3" json:"d1,omitempty"`
+// PolicyChangeEvent is published after an RPC changed the policy of an enforcer.
+type PolicyChangeEvent struct {
+	Tenant               string   `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	EnforcerHandler      int32    `protobuf:"varint,2,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	Method               string   `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Array2DReplyD) Reset()         { *m = Array2DReplyD{} }
-func (m *Array2DReplyD) String() string { return proto.CompactTextString(m) }
-func (*Array2DReplyD) ProtoMessage()    {}
-func (*Array2DReplyD) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{14, 0}
+func (m *PolicyChangeEvent) Reset()         { *m = PolicyChangeEvent{} }
+func (m *PolicyChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*PolicyChangeEvent) ProtoMessage()    {}
+func (*PolicyChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f835afd114115d9e, []int{45}
 }
 
-func (m *Array2DReplyD) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Array2DReplyD.Unmarshal(m, b)
+func (m *PolicyChangeEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyChangeEvent.Unmarshal(m, b)
 }
-func (m *Array2DReplyD) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Array2DReplyD.Marshal(b, m, deterministic)
+func (m *PolicyChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyChangeEvent.Marshal(b, m, deterministic)
 }
-func (m *Array2DReplyD) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Array2DReplyD.Merge(m, src)
+func (m *PolicyChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyChangeEvent.Merge(m, src)
 }
-func (m *Array2DReplyD) XXX_Size() int {
-	return xxx_messageInfo_Array2DReplyD.Size(m)
+func (m *PolicyChangeEvent) XXX_Size() int {
+	return xxx_messageInfo_PolicyChangeEvent.Size(m)
 }
-func (m *Array2DReplyD) XXX_DiscardUnknown() {
-	xxx_messageInfo_Array2DReplyD.DiscardUnknown(m)
+func (m *PolicyChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyChangeEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Array2DReplyD proto.InternalMessageInfo
+var xxx_messageInfo_PolicyChangeEvent proto.InternalMessageInfo
 
-func (m *Array2DReplyD) GetD1() []string {
+func (m *PolicyChangeEvent) GetTenant() string {
 	if m != nil {
-		return m.D1
+		return m.Tenant
 	}
-	return nil
+	return ""
+}
+
+func (m *PolicyChangeEvent) GetEnforcerHandler() int32 {
+	if m != nil {
+		return m.EnforcerHandler
+	}
+	return 0
+}
+
+func (m *PolicyChangeEvent) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
 }
 
 type Message struct {
@@ -759,7 +2573,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{15}
+	return fileDescriptor_f835afd114115d9e, []int{46}
 }
 
 func (m *Message) XXX_Unmarshal(b []byte) error {
@@ -798,7 +2612,7 @@ func (m *StreamingRequest) Reset()         { *m = StreamingRequest{} }
 func (m *StreamingRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamingRequest) ProtoMessage()    {}
 func (*StreamingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{16}
+	return fileDescriptor_f835afd114115d9e, []int{47}
 }
 
 func (m *StreamingRequest) XXX_Unmarshal(b []byte) error {
@@ -837,7 +2651,7 @@ func (m *StreamingResponse) Reset()         { *m = StreamingResponse{} }
 func (m *StreamingResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamingResponse) ProtoMessage()    {}
 func (*StreamingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{17}
+	return fileDescriptor_f835afd114115d9e, []int{48}
 }
 
 func (m *StreamingResponse) XXX_Unmarshal(b []byte) error {
@@ -876,7 +2690,7 @@ func (m *Ping) Reset()         { *m = Ping{} }
 func (m *Ping) String() string { return proto.CompactTextString(m) }
 func (*Ping) ProtoMessage()    {}
 func (*Ping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{18}
+	return fileDescriptor_f835afd114115d9e, []int{49}
 }
 
 func (m *Ping) XXX_Unmarshal(b []byte) error {
@@ -915,7 +2729,7 @@ func (m *Pong) Reset()         { *m = Pong{} }
 func (m *Pong) String() string { return proto.CompactTextString(m) }
 func (*Pong) ProtoMessage()    {}
 func (*Pong) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f835afd114115d9e, []int{19}
+	return fileDescriptor_f835afd114115d9e, []int{50}
 }
 
 func (m *Pong) XXX_Unmarshal(b []byte) error {
@@ -947,19 +2761,51 @@ func init() {
 	proto.RegisterType((*NewEnforcerRequest)(nil), "go.micro.srv.casbin.NewEnforcerRequest")
 	proto.RegisterType((*NewEnforcerReply)(nil), "go.micro.srv.casbin.NewEnforcerReply")
 	proto.RegisterType((*NewAdapterRequest)(nil), "go.micro.srv.casbin.NewAdapterRequest")
+	proto.RegisterMapType((map[string]string)(nil), "go.micro.srv.casbin.NewAdapterRequest.ParamsEntry")
+	proto.RegisterType((*PoolOptions)(nil), "go.micro.srv.casbin.PoolOptions")
 	proto.RegisterType((*NewAdapterReply)(nil), "go.micro.srv.casbin.NewAdapterReply")
+	proto.RegisterType((*AdapterDriver)(nil), "go.micro.srv.casbin.AdapterDriver")
+	proto.RegisterType((*AdapterDriversReply)(nil), "go.micro.srv.casbin.AdapterDriversReply")
+	proto.RegisterType((*CheckAdapterRequest)(nil), "go.micro.srv.casbin.CheckAdapterRequest")
+	proto.RegisterType((*CheckAdapterReply)(nil), "go.micro.srv.casbin.CheckAdapterReply")
 	proto.RegisterType((*EnforceRequest)(nil), "go.micro.srv.casbin.EnforceRequest")
+	proto.RegisterType((*WhoCanRequest)(nil), "go.micro.srv.casbin.WhoCanRequest")
+	proto.RegisterType((*ListAllowedRequest)(nil), "go.micro.srv.casbin.ListAllowedRequest")
 	proto.RegisterType((*BoolReply)(nil), "go.micro.srv.casbin.BoolReply")
 	proto.RegisterType((*EmptyRequest)(nil), "go.micro.srv.casbin.EmptyRequest")
+	proto.RegisterType((*ListRequest)(nil), "go.micro.srv.casbin.ListRequest")
 	proto.RegisterType((*EmptyReply)(nil), "go.micro.srv.casbin.EmptyReply")
+	proto.RegisterType((*PolicyFilter)(nil), "go.micro.srv.casbin.PolicyFilter")
+	proto.RegisterType((*LoadFilteredPolicyRequest)(nil), "go.micro.srv.casbin.LoadFilteredPolicyRequest")
+	proto.RegisterType((*ExportPolicyRequest)(nil), "go.micro.srv.casbin.ExportPolicyRequest")
+	proto.RegisterType((*PolicyDataReply)(nil), "go.micro.srv.casbin.PolicyDataReply")
+	proto.RegisterType((*ImportPolicyRequest)(nil), "go.micro.srv.casbin.ImportPolicyRequest")
+	proto.RegisterType((*ImportPolicyReply)(nil), "go.micro.srv.casbin.ImportPolicyReply")
 	proto.RegisterType((*PolicyRequest)(nil), "go.micro.srv.casbin.PolicyRequest")
 	proto.RegisterType((*SimpleGetRequest)(nil), "go.micro.srv.casbin.SimpleGetRequest")
 	proto.RegisterType((*ArrayReply)(nil), "go.micro.srv.casbin.ArrayReply")
 	proto.RegisterType((*FilteredPolicyRequest)(nil), "go.micro.srv.casbin.FilteredPolicyRequest")
+	proto.RegisterType((*PageOptions)(nil), "go.micro.srv.casbin.PageOptions")
 	proto.RegisterType((*UserRoleRequest)(nil), "go.micro.srv.casbin.UserRoleRequest")
 	proto.RegisterType((*PermissionRequest)(nil), "go.micro.srv.casbin.PermissionRequest")
 	proto.RegisterType((*Array2DReply)(nil), "go.micro.srv.casbin.Array2DReply")
 	proto.RegisterType((*Array2DReplyD)(nil), "go.micro.srv.casbin.Array2DReply.d")
+	proto.RegisterType((*InheritedRule)(nil), "go.micro.srv.casbin.InheritedRule")
+	proto.RegisterType((*InheritedReply)(nil), "go.micro.srv.casbin.InheritedReply")
+	proto.RegisterType((*RoleGraphRequest)(nil), "go.micro.srv.casbin.RoleGraphRequest")
+	proto.RegisterType((*RoleGraphReply)(nil), "go.micro.srv.casbin.RoleGraphReply")
+	proto.RegisterType((*RolePath)(nil), "go.micro.srv.casbin.RolePath")
+	proto.RegisterType((*CheckRoleGraphReply)(nil), "go.micro.srv.casbin.CheckRoleGraphReply")
+	proto.RegisterType((*PolicyFinding)(nil), "go.micro.srv.casbin.PolicyFinding")
+	proto.RegisterType((*AnalyzePolicyReply)(nil), "go.micro.srv.casbin.AnalyzePolicyReply")
+	proto.RegisterType((*ModelTemplate)(nil), "go.micro.srv.casbin.ModelTemplate")
+	proto.RegisterType((*ModelTemplatesReply)(nil), "go.micro.srv.casbin.ModelTemplatesReply")
+	proto.RegisterType((*VersionReply)(nil), "go.micro.srv.casbin.VersionReply")
+	proto.RegisterType((*EnforcerStatus)(nil), "go.micro.srv.casbin.EnforcerStatus")
+	proto.RegisterType((*AdapterStatus)(nil), "go.micro.srv.casbin.AdapterStatus")
+	proto.RegisterType((*HealthReply)(nil), "go.micro.srv.casbin.HealthReply")
+	proto.RegisterType((*ReadinessReply)(nil), "go.micro.srv.casbin.ReadinessReply")
+	proto.RegisterType((*PolicyChangeEvent)(nil), "go.micro.srv.casbin.PolicyChangeEvent")
 	proto.RegisterType((*Message)(nil), "go.micro.srv.casbin.Message")
 	proto.RegisterType((*StreamingRequest)(nil), "go.micro.srv.casbin.StreamingRequest")
 	proto.RegisterType((*StreamingResponse)(nil), "go.micro.srv.casbin.StreamingResponse")
@@ -970,66 +2816,170 @@ func init() {
 func init() { proto.RegisterFile("proto/casbin/casbin.proto", fileDescriptor_f835afd114115d9e) }
 
 var fileDescriptor_f835afd114115d9e = []byte{
-	// 962 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xff, 0x72, 0xda, 0x46,
-	0x10, 0x36, 0x60, 0xec, 0xb2, 0xf8, 0x07, 0x9c, 0x5d, 0x0f, 0x26, 0x29, 0xc5, 0xd7, 0xb4, 0xa5,
-	0x3f, 0x86, 0x4e, 0xc8, 0x13, 0xd0, 0x36, 0x85, 0xce, 0xb4, 0x8e, 0x23, 0x1c, 0x4f, 0x32, 0xf5,
-	0x24, 0x73, 0xd6, 0x6d, 0xa8, 0x5a, 0xa1, 0x93, 0xef, 0x84, 0x13, 0xa6, 0x4f, 0xd2, 0x87, 0xe8,
-	0x3b, 0x76, 0x74, 0x92, 0xb0, 0x44, 0xe4, 0x58, 0x71, 0xa4, 0xbf, 0xac, 0xdb, 0x5b, 0x7d, 0xdf,
-	0xb7, 0xbb, 0xb7, 0xcb, 0xc9, 0x70, 0xe8, 0x4a, 0xe1, 0x89, 0x1f, 0x4c, 0xa6, 0x2e, 0x2c, 0x27,
-	0xfc, 0xd3, 0xd7, 0x36, 0xb2, 0x37, 0x15, 0xfd, 0x99, 0x65, 0x4a, 0xd1, 0x57, 0xf2, 0xaa, 0x1f,
-	0x6c, 0xd1, 0xe7, 0x40, 0x8e, 0xf1, 0xcd, 0x63, 0xe7, 0xb5, 0x90, 0x26, 0x4a, 0x03, 0x2f, 0xe7,
-	0xa8, 0x3c, 0x72, 0x1f, 0x6a, 0x33, 0xc1, 0xd1, 0x3e, 0xc5, 0xb7, 0x5e, 0xab, 0xd4, 0x2d, 0xf5,
-	0x6a, 0xc6, 0xb5, 0x81, 0x3c, 0x80, 0x6d, 0xc6, 0x99, 0xeb, 0xa1, 0x1c, 0x33, 0x87, 0xdb, 0xd8,
-	0x2a, 0x77, 0x4b, 0xbd, 0xaa, 0x91, 0x34, 0xd2, 0xef, 0xa1, 0x91, 0x40, 0x76, 0xed, 0x05, 0x69,
-	0xc1, 0xe6, 0x9f, 0x7a, 0x57, 0x6a, 0xd4, 0xaa, 0x11, 0x2d, 0xe9, 0x3f, 0xd0, 0x3c, 0xc6, 0x37,
-	0xc3, 0x00, 0x21, 0x92, 0xd1, 0x85, 0x7a, 0x88, 0x79, 0xcc, 0x66, 0x18, 0x0a, 0x89, 0x9b, 0x48,
-	0x07, 0x80, 0x4b, 0xeb, 0x2a, 0x74, 0x28, 0x6b, 0x87, 0x98, 0xc5, 0x97, 0x6a, 0x0a, 0xc7, 0x41,
-	0xd3, 0x9b, 0x78, 0xd2, 0x72, 0xa6, 0xad, 0x8a, 0x76, 0x49, 0x1a, 0xe9, 0x77, 0xb0, 0x1b, 0x27,
-	0x7f, 0xbf, 0x52, 0x03, 0x76, 0xc2, 0xa0, 0x22, 0x99, 0x3d, 0xd8, 0xc5, 0x30, 0xcc, 0x71, 0xe2,
-	0x9d, 0x55, 0x33, 0x39, 0x80, 0x0d, 0x97, 0x49, 0x36, 0x53, 0xad, 0x72, 0xb7, 0xd2, 0xab, 0x19,
-	0xe1, 0x8a, 0x7e, 0x06, 0xb5, 0x1f, 0x85, 0xb0, 0x03, 0xea, 0x06, 0x54, 0x24, 0x2a, 0x0d, 0xf1,
-	0x89, 0xe1, 0x3f, 0xd2, 0x1e, 0x6c, 0x3d, 0x9e, 0xb9, 0xde, 0x22, 0x22, 0xbc, 0x59, 0xdc, 0x16,
-	0x40, 0xe8, 0xe9, 0xda, 0x0b, 0x3a, 0x85, 0xed, 0x13, 0x61, 0x5b, 0xe6, 0xe2, 0xc3, 0x95, 0xee,
-	0x43, 0xd5, 0x3d, 0x5d, 0xb8, 0x51, 0x4e, 0x83, 0x45, 0x4c, 0x7f, 0x25, 0xa1, 0xdf, 0x80, 0xc6,
-	0xc4, 0x9a, 0xb9, 0x36, 0x8e, 0xd0, 0xcb, 0x89, 0x8b, 0x52, 0x80, 0xa1, 0x94, 0x2c, 0x08, 0xc5,
-	0xf7, 0x61, 0xfe, 0xaa, 0x55, 0xd2, 0xc4, 0xc1, 0x82, 0xfe, 0x5b, 0x82, 0x4f, 0x7f, 0xb1, 0x6c,
-	0x0f, 0x25, 0xf2, 0x7c, 0x23, 0xed, 0x00, 0xbc, 0xb6, 0xd0, 0xe6, 0xbf, 0x3a, 0x1c, 0xdf, 0xea,
-	0x53, 0x53, 0x35, 0x62, 0x16, 0xff, 0x68, 0xea, 0xd5, 0x19, 0xb3, 0xe7, 0xa8, 0x5a, 0xeb, 0x5a,
-	0x55, 0xdc, 0x44, 0x4d, 0xd8, 0x7d, 0xa6, 0x50, 0x1a, 0xc2, 0xbe, 0xc3, 0x41, 0x21, 0xb0, 0x3e,
-	0x57, 0x28, 0x43, 0x4d, 0xfa, 0xd9, 0xb7, 0x49, 0x61, 0x63, 0x78, 0x84, 0xf5, 0x33, 0x55, 0xd0,
-	0x3c, 0x41, 0x39, 0xb3, 0x94, 0xb2, 0x84, 0x93, 0x0f, 0x4d, 0x17, 0xea, 0xee, 0x12, 0x32, 0x2a,
-	0x74, 0xdc, 0x44, 0x9f, 0xc3, 0x96, 0xae, 0xcc, 0xe0, 0xe7, 0xa0, 0x36, 0x8f, 0xa0, 0xcc, 0x07,
-	0xba, 0x30, 0xf5, 0xc1, 0x17, 0xfd, 0x94, 0x29, 0xd3, 0x8f, 0xbb, 0xf7, 0xb9, 0x51, 0xe6, 0x83,
-	0xf6, 0x1e, 0x94, 0x38, 0xd9, 0x81, 0x32, 0x7f, 0x18, 0x96, 0xb4, 0xcc, 0x1f, 0xd2, 0x7b, 0xb0,
-	0xf9, 0x3b, 0x2a, 0xc5, 0xa6, 0xe8, 0x77, 0x81, 0xd2, 0xe5, 0xf6, 0x95, 0xf9, 0x8f, 0xb4, 0x07,
-	0x8d, 0x89, 0x27, 0x91, 0xcd, 0x2c, 0x67, 0x1a, 0x85, 0xba, 0x0f, 0x55, 0x53, 0xcc, 0x9d, 0x60,
-	0x48, 0x55, 0x8c, 0x60, 0x41, 0xbf, 0x81, 0x66, 0xcc, 0x53, 0xb9, 0xc2, 0x51, 0x78, 0x83, 0x6b,
-	0x07, 0xd6, 0x4f, 0x2c, 0x67, 0xea, 0x9f, 0x6c, 0xe5, 0x49, 0xf1, 0x37, 0x86, 0xdb, 0xe1, 0x4a,
-	0xef, 0x8b, 0x9b, 0xf7, 0x07, 0xff, 0xdd, 0x83, 0x8d, 0x9f, 0x74, 0x90, 0xe4, 0x15, 0xd4, 0x63,
-	0x03, 0x8f, 0x7c, 0x9d, 0x9a, 0x89, 0x77, 0x87, 0x6d, 0xfb, 0xcb, 0xdb, 0x1d, 0xfd, 0x66, 0x5e,
-	0x23, 0xe7, 0x00, 0xd7, 0x63, 0x8a, 0x7c, 0x75, 0xd3, 0x6b, 0xc9, 0x21, 0xda, 0x7e, 0x70, 0xab,
-	0x5f, 0x80, 0x7e, 0x02, 0x9b, 0x21, 0x21, 0x49, 0x2f, 0x62, 0x72, 0xea, 0xb5, 0x3b, 0xa9, 0x4e,
-	0xcb, 0x31, 0x46, 0xd7, 0x88, 0x01, 0xf0, 0x9b, 0x60, 0x61, 0x63, 0x92, 0xa3, 0x74, 0xd0, 0xd8,
-	0x5c, 0x6b, 0x7f, 0xfe, 0x3e, 0x97, 0x25, 0xe6, 0x84, 0x5d, 0x61, 0xae, 0x98, 0x4f, 0xa1, 0x36,
-	0xe4, 0x91, 0x4c, 0x9a, 0xea, 0x9f, 0x18, 0x2e, 0x19, 0x42, 0x3f, 0x83, 0x9d, 0x21, 0xe7, 0xfe,
-	0x4f, 0x50, 0xbe, 0xb8, 0xa7, 0xb0, 0x65, 0xe0, 0x4c, 0x2c, 0x13, 0x90, 0x0f, 0xea, 0x0b, 0x68,
-	0x06, 0xa8, 0xf9, 0x0b, 0xe6, 0xb0, 0x1f, 0x40, 0x27, 0xc7, 0x34, 0xf9, 0x36, 0xf5, 0xcd, 0xd4,
-	0x59, 0x9e, 0x81, 0xc5, 0x82, 0xc3, 0x24, 0x4b, 0x3c, 0x90, 0x7c, 0xa9, 0x26, 0x50, 0x1b, 0xa1,
-	0x97, 0xfd, 0xfc, 0x1d, 0xdd, 0x3a, 0x10, 0x75, 0x01, 0x76, 0x46, 0xe8, 0x7d, 0x68, 0xf6, 0x33,
-	0x41, 0x73, 0x68, 0x8e, 0xd0, 0xfb, 0x88, 0xec, 0x67, 0x62, 0xb1, 0xe0, 0x20, 0xc6, 0x72, 0xd7,
-	0xec, 0x67, 0xcc, 0x55, 0x73, 0xc8, 0xf9, 0x48, 0x8a, 0xb9, 0x6b, 0x39, 0xd3, 0x5c, 0x0f, 0xeb,
-	0x4b, 0x38, 0x88, 0xba, 0xb6, 0x10, 0xfc, 0xf3, 0xa8, 0x19, 0x0a, 0x41, 0x67, 0x51, 0x13, 0x14,
-	0x17, 0x80, 0x0d, 0xf7, 0x93, 0x7d, 0xb6, 0xc2, 0x92, 0x6f, 0xab, 0x5d, 0xc2, 0x51, 0x4a, 0x57,
-	0x17, 0x4a, 0xf9, 0x87, 0xee, 0x96, 0x15, 0x8a, 0xbc, 0xba, 0x9c, 0xe9, 0x26, 0xb9, 0x6b, 0x75,
-	0x32, 0x51, 0xd8, 0x70, 0x18, 0xeb, 0xc3, 0x8f, 0x48, 0x55, 0x26, 0xb6, 0x4b, 0xe8, 0xac, 0x76,
-	0x7d, 0xd1, 0x94, 0x67, 0x7a, 0x52, 0x0e, 0x6d, 0x7b, 0x32, 0xbf, 0xf8, 0x0b, 0x4d, 0x4f, 0xdd,
-	0xfd, 0x0e, 0x70, 0xfd, 0x75, 0x41, 0xd7, 0xc8, 0x2b, 0xd8, 0x0b, 0x70, 0x75, 0x14, 0x4b, 0xf0,
-	0xf4, 0xbb, 0xd9, 0xea, 0xb7, 0x4e, 0x16, 0x82, 0x67, 0xb0, 0x1d, 0x10, 0x3c, 0xc9, 0x55, 0xf7,
-	0x4b, 0x20, 0x31, 0xdd, 0x4f, 0x8a, 0x93, 0x3d, 0x34, 0x3d, 0xff, 0xf2, 0x5f, 0x88, 0xec, 0x08,
-	0x3b, 0x3f, 0xd9, 0x13, 0xa8, 0x07, 0xf8, 0xfe, 0xe7, 0x57, 0x5e, 0xa2, 0xcf, 0xa1, 0x11, 0x13,
-	0x1d, 0x20, 0xe7, 0x27, 0xf9, 0x29, 0xd4, 0xc6, 0x4c, 0xe5, 0x7d, 0x0b, 0x1d, 0x33, 0x95, 0xff,
-	0xa5, 0xee, 0x05, 0x34, 0xc7, 0x4c, 0x15, 0xf5, 0x13, 0x1c, 0x49, 0x2e, 0x02, 0xff, 0x62, 0x43,
-	0xff, 0x2f, 0xec, 0xd1, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x86, 0x66, 0x7f, 0xc0, 0x28, 0x13,
-	0x00, 0x00,
+	// 2633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3b, 0x4b, 0x73, 0xdc, 0xc6,
+	0xd1, 0xda, 0x17, 0xc9, 0xed, 0xe5, 0x63, 0x09, 0xd2, 0xf2, 0x8a, 0x9f, 0x25, 0x53, 0x23, 0x7f,
+	0x0a, 0x93, 0x54, 0x31, 0x65, 0x3a, 0xa9, 0xd8, 0xb1, 0x9d, 0x32, 0x43, 0x52, 0x24, 0x55, 0x7a,
+	0x50, 0x20, 0x25, 0x59, 0xb6, 0x6c, 0x69, 0xb8, 0x18, 0x72, 0x61, 0x02, 0x18, 0x08, 0xc0, 0x52,
+	0xa4, 0x2b, 0x95, 0x54, 0xe5, 0x96, 0x4b, 0xaa, 0x52, 0x95, 0x8b, 0x0f, 0x39, 0xe4, 0x2f, 0xe4,
+	0x94, 0xbf, 0x90, 0x4b, 0x72, 0xcf, 0x3d, 0xff, 0x23, 0x35, 0x2f, 0x60, 0xb0, 0xc4, 0x72, 0xb1,
+	0x4b, 0xec, 0x89, 0xd3, 0x8d, 0x9e, 0xee, 0x9e, 0x9e, 0xee, 0x9e, 0x9e, 0x9e, 0x25, 0xdc, 0xf0,
+	0x03, 0x1a, 0xd1, 0x9f, 0xb5, 0x71, 0x78, 0x68, 0x7b, 0xf2, 0xcf, 0x2a, 0xc7, 0x19, 0x0b, 0xc7,
+	0x74, 0xd5, 0xb5, 0xdb, 0x01, 0x5d, 0x0d, 0x83, 0xd3, 0x55, 0xf1, 0x09, 0xfd, 0x0e, 0x8c, 0x47,
+	0xe4, 0xed, 0x96, 0x77, 0x44, 0x83, 0x36, 0x09, 0x4c, 0xf2, 0xa6, 0x4b, 0xc2, 0xc8, 0x78, 0x0f,
+	0xea, 0x2e, 0xb5, 0x88, 0x73, 0x40, 0xce, 0xa2, 0x56, 0x69, 0xb9, 0xb4, 0x52, 0x37, 0x13, 0x84,
+	0xf1, 0x01, 0xcc, 0x60, 0x0b, 0xfb, 0x11, 0x09, 0x76, 0xb0, 0x67, 0x39, 0xa4, 0x55, 0x5e, 0x2e,
+	0xad, 0xd4, 0xcc, 0x34, 0x92, 0x51, 0xc9, 0x29, 0xae, 0xef, 0xe0, 0x88, 0xb4, 0x2a, 0x9c, 0x4f,
+	0x1a, 0x89, 0xee, 0x43, 0x33, 0x25, 0xdf, 0x77, 0xce, 0x8d, 0x16, 0x4c, 0x76, 0x38, 0x8f, 0x80,
+	0xcb, 0xae, 0x99, 0x0a, 0x64, 0x7a, 0x39, 0x14, 0x5b, 0x5b, 0x41, 0x40, 0x03, 0x2e, 0xb5, 0x6e,
+	0x26, 0x08, 0xf4, 0x8f, 0x32, 0xcc, 0x3f, 0x22, 0x6f, 0xd7, 0x85, 0x1a, 0x6a, 0x2d, 0xcb, 0xd0,
+	0x90, 0x8a, 0x3d, 0xc2, 0x2e, 0x91, 0xab, 0xd1, 0x51, 0xc6, 0x2d, 0x00, 0x2b, 0xb0, 0x4f, 0x25,
+	0x81, 0x60, 0xab, 0x61, 0xd8, 0x4a, 0xda, 0xd4, 0xf3, 0x48, 0x3b, 0xda, 0x8f, 0x02, 0xdb, 0x3b,
+	0x56, 0x2b, 0x49, 0x21, 0x8d, 0xfb, 0x30, 0xe1, 0xe3, 0x00, 0xbb, 0x61, 0xab, 0xba, 0x5c, 0x59,
+	0x69, 0xac, 0xad, 0xad, 0x66, 0xd8, 0x7b, 0xf5, 0x82, 0x7e, 0xab, 0x7b, 0x7c, 0xd2, 0x96, 0x17,
+	0x05, 0xe7, 0xa6, 0xe4, 0x60, 0xfc, 0x1c, 0xaa, 0x3e, 0xa5, 0x4e, 0xab, 0xb6, 0x5c, 0x5a, 0x69,
+	0xac, 0x2d, 0x67, 0x72, 0xda, 0xa3, 0xd4, 0x79, 0xec, 0x47, 0x36, 0xf5, 0x42, 0x93, 0x53, 0x2f,
+	0x7d, 0x02, 0x0d, 0x8d, 0x99, 0xd1, 0x84, 0xca, 0x09, 0x39, 0x97, 0x0b, 0x66, 0x43, 0x63, 0x11,
+	0x6a, 0xa7, 0xd8, 0xe9, 0xaa, 0x35, 0x0a, 0xe0, 0x57, 0xe5, 0x8f, 0x4b, 0xe8, 0x87, 0x12, 0x34,
+	0x34, 0x86, 0x06, 0x82, 0x69, 0x17, 0x9f, 0x3d, 0xf6, 0x89, 0xb7, 0x41, 0x3d, 0x2f, 0x94, 0xfb,
+	0x90, 0xc2, 0x49, 0x9a, 0x5d, 0xcb, 0x21, 0x82, 0xa6, 0x1c, 0xd3, 0xc4, 0x38, 0x63, 0x05, 0xe6,
+	0x98, 0x95, 0x1e, 0xe2, 0xb3, 0x07, 0xf6, 0x11, 0x89, 0x6c, 0x57, 0xb9, 0x41, 0x2f, 0x9a, 0x6d,
+	0x3a, 0xfb, 0x4b, 0xbb, 0x51, 0xab, 0xca, 0x29, 0x14, 0x88, 0x7e, 0x0a, 0x73, 0xba, 0xd5, 0x2e,
+	0xf5, 0x10, 0xf4, 0x04, 0x66, 0x24, 0xe5, 0x26, 0xdf, 0xc0, 0xab, 0x6f, 0x3f, 0xda, 0x87, 0x85,
+	0x14, 0xcb, 0x50, 0xe8, 0xf0, 0x19, 0x4c, 0x0a, 0x22, 0x66, 0x1d, 0xb6, 0xe1, 0x28, 0x73, 0x9b,
+	0x52, 0x53, 0x4d, 0x35, 0x05, 0x7d, 0x0a, 0x0b, 0x1b, 0x1d, 0xd2, 0x3e, 0xe9, 0x71, 0xd6, 0x0b,
+	0xa1, 0x55, 0xca, 0x08, 0x2d, 0xb4, 0x0d, 0xf3, 0xe9, 0xc9, 0xca, 0x26, 0x04, 0x3b, 0x51, 0x47,
+	0x6c, 0xf9, 0x94, 0xa9, 0x40, 0xf6, 0xc5, 0x25, 0x61, 0x88, 0x8f, 0xd5, 0xea, 0x14, 0x88, 0x4c,
+	0x98, 0x95, 0xa1, 0xa7, 0x14, 0x58, 0x81, 0x39, 0x22, 0x30, 0xc1, 0x4e, 0xca, 0xc2, 0xbd, 0x68,
+	0xe3, 0x7a, 0xec, 0xef, 0xe5, 0xe5, 0xca, 0x4a, 0x5d, 0xf9, 0x2e, 0xea, 0xc2, 0xcc, 0xf3, 0x0e,
+	0xdd, 0xc0, 0xde, 0xf0, 0x2c, 0x9b, 0x50, 0xa1, 0x87, 0xdf, 0x49, 0x25, 0xd9, 0x90, 0x61, 0x70,
+	0x3b, 0x92, 0x3e, 0xc3, 0x86, 0x4c, 0xac, 0x45, 0x5d, 0x6c, 0x7b, 0xd2, 0x4d, 0x24, 0x84, 0xfe,
+	0x55, 0x02, 0xe3, 0x81, 0x1d, 0x46, 0xeb, 0x8e, 0x43, 0xdf, 0x12, 0x6b, 0x78, 0xe1, 0x2d, 0x98,
+	0x0c, 0xbb, 0x87, 0xdf, 0x91, 0x76, 0xa4, 0xac, 0x24, 0x41, 0x4d, 0x64, 0x45, 0x17, 0xc9, 0x02,
+	0x80, 0x72, 0x8a, 0xbd, 0x80, 0x1c, 0xd9, 0x67, 0x52, 0xa1, 0x14, 0xce, 0x58, 0x82, 0x29, 0x1f,
+	0x1f, 0x93, 0x7d, 0xfb, 0x7b, 0xc2, 0xa3, 0xb9, 0x66, 0xc6, 0x30, 0xcb, 0x66, 0x6c, 0x7c, 0x40,
+	0x4f, 0x88, 0xd7, 0x9a, 0x10, 0xd9, 0x2c, 0x46, 0xa0, 0x9b, 0x50, 0xff, 0x0d, 0xa5, 0x8e, 0xd8,
+	0xdc, 0x26, 0x54, 0x02, 0x12, 0xca, 0x8d, 0x65, 0x43, 0xb4, 0x02, 0xd3, 0x5b, 0xae, 0x1f, 0x9d,
+	0xab, 0x85, 0xf6, 0x0f, 0x89, 0x6f, 0xa0, 0xc1, 0x0c, 0x33, 0x90, 0x90, 0x67, 0x1d, 0xe5, 0x24,
+	0x7d, 0xb3, 0x0e, 0x3e, 0x26, 0x49, 0xd6, 0x61, 0x3e, 0x34, 0x0d, 0x20, 0x15, 0xf1, 0x9d, 0x73,
+	0x74, 0x04, 0xd3, 0x7b, 0xd4, 0xb1, 0xdb, 0xe7, 0xf7, 0x6c, 0x27, 0x22, 0x01, 0x4b, 0x39, 0xfe,
+	0xc1, 0xb9, 0xaf, 0x02, 0x4f, 0x00, 0x2c, 0xe4, 0x8e, 0x6c, 0xe2, 0x58, 0xbb, 0x9e, 0x45, 0xce,
+	0x64, 0xe2, 0xd0, 0x30, 0x2c, 0x68, 0x39, 0xf4, 0x8c, 0x25, 0xa8, 0xb0, 0x55, 0xe1, 0x0e, 0xa6,
+	0xa3, 0xd0, 0x1f, 0x4a, 0x70, 0xe3, 0x01, 0xc5, 0x96, 0x10, 0x43, 0x2c, 0x21, 0x74, 0xf8, 0x5d,
+	0xff, 0x14, 0x26, 0x8f, 0x38, 0x0b, 0xe1, 0xc6, 0x8d, 0xb5, 0xdb, 0x7d, 0x92, 0x6d, 0xb2, 0x26,
+	0x53, 0xcd, 0x40, 0xcf, 0x61, 0x61, 0xeb, 0xcc, 0xa7, 0x41, 0x34, 0xaa, 0xf4, 0xeb, 0x30, 0x71,
+	0x44, 0x03, 0x17, 0x2b, 0x97, 0x93, 0x10, 0xfa, 0x1c, 0xe6, 0x04, 0xcb, 0x4d, 0x1c, 0x61, 0xe1,
+	0x01, 0x09, 0x69, 0x49, 0x27, 0x35, 0x0c, 0xa8, 0x5a, 0x38, 0xc2, 0x92, 0x01, 0x1f, 0xa3, 0xdf,
+	0xc3, 0xc2, 0xae, 0x3b, 0x06, 0xbd, 0x62, 0x61, 0x95, 0x44, 0x18, 0xc3, 0xb1, 0x23, 0x5d, 0x7a,
+	0x3f, 0x1f, 0xa3, 0x0d, 0x98, 0x4f, 0x2b, 0xc0, 0x56, 0xb0, 0x08, 0x35, 0x6c, 0x59, 0xc4, 0x92,
+	0x42, 0x05, 0xc0, 0xc3, 0xee, 0xc4, 0xf6, 0x7d, 0x62, 0x49, 0x3f, 0x50, 0x20, 0xfa, 0x6b, 0x09,
+	0x66, 0x46, 0x5d, 0x40, 0xec, 0x76, 0x65, 0xdd, 0xed, 0x92, 0x94, 0x55, 0xd1, 0x53, 0x56, 0xec,
+	0xf8, 0xd5, 0xa1, 0x1c, 0xdf, 0x84, 0xe6, 0xbe, 0xed, 0xfa, 0x0e, 0xd9, 0x26, 0x51, 0x41, 0x1a,
+	0x22, 0x04, 0xb0, 0x1e, 0x04, 0x58, 0xb3, 0x18, 0x83, 0xf8, 0x01, 0x53, 0x37, 0x05, 0x80, 0xfe,
+	0x5d, 0x82, 0x77, 0xae, 0xea, 0xf6, 0xd9, 0xf6, 0x49, 0x87, 0x65, 0x65, 0x50, 0x58, 0x56, 0x2f,
+	0x84, 0x65, 0x6c, 0xc9, 0xda, 0x50, 0x96, 0xfc, 0x63, 0x19, 0x1a, 0x1a, 0x36, 0x95, 0x34, 0x4b,
+	0x97, 0x25, 0xcd, 0x72, 0x4f, 0xd2, 0x34, 0xee, 0xc2, 0x6c, 0x48, 0x83, 0xe8, 0x5e, 0xef, 0x2a,
+	0x7a, 0xb0, 0x8a, 0x6e, 0x93, 0x84, 0x6d, 0xe2, 0x59, 0xac, 0xa6, 0xab, 0xf2, 0xd4, 0xda, 0x83,
+	0x65, 0x1e, 0x13, 0x12, 0x1c, 0xb4, 0x3b, 0x7c, 0x45, 0x75, 0x53, 0x42, 0xcc, 0x52, 0x62, 0xf4,
+	0x90, 0xb9, 0xfe, 0x24, 0xff, 0xa6, 0x61, 0x98, 0xa5, 0x04, 0xc4, 0x65, 0xb6, 0xa6, 0xb8, 0x12,
+	0x3a, 0xea, 0x7e, 0x75, 0x6a, 0xa2, 0x39, 0x69, 0x36, 0x35, 0x14, 0xd7, 0x0c, 0xfd, 0xb9, 0x04,
+	0x73, 0x4f, 0x43, 0x12, 0x98, 0xd4, 0x19, 0xe1, 0x50, 0x36, 0xa0, 0xda, 0x0d, 0x89, 0xaa, 0x8d,
+	0xf9, 0x98, 0xe1, 0x02, 0xea, 0xa8, 0xc2, 0x8b, 0x8f, 0xfb, 0x9d, 0xa2, 0x89, 0x5f, 0xd4, 0x74,
+	0xaf, 0xfc, 0x5b, 0x09, 0xe6, 0xf7, 0x48, 0xe0, 0xda, 0x61, 0x68, 0x53, 0xaf, 0x18, 0xad, 0x96,
+	0xa1, 0xe1, 0xc7, 0x2c, 0xe3, 0x14, 0xaf, 0xa1, 0x86, 0xd4, 0xf1, 0x2f, 0x25, 0x98, 0xe6, 0xa1,
+	0xb3, 0xb6, 0x29, 0x82, 0xe7, 0x23, 0x28, 0x5b, 0x6b, 0xb2, 0x34, 0xbb, 0x93, 0x5d, 0x9a, 0x69,
+	0xe4, 0xab, 0x96, 0x59, 0xb6, 0xd6, 0x58, 0xfd, 0xe5, 0x91, 0xb3, 0x68, 0xaf, 0xc7, 0xc3, 0xd2,
+	0x48, 0xa6, 0x41, 0x44, 0x23, 0xec, 0x48, 0xe7, 0x12, 0xc0, 0xd2, 0x02, 0x94, 0x2c, 0x63, 0x16,
+	0xca, 0xd6, 0x87, 0x32, 0x5e, 0xcb, 0xd6, 0x87, 0xe8, 0x39, 0xcc, 0xec, 0x7a, 0x1d, 0x12, 0xd8,
+	0x11, 0xb1, 0xcc, 0xae, 0x43, 0xf8, 0x6e, 0x74, 0x1d, 0x22, 0x49, 0xaa, 0x81, 0xc4, 0xf9, 0x38,
+	0xea, 0xc8, 0x42, 0x8a, 0x8f, 0x99, 0x87, 0xc9, 0x42, 0x82, 0x7d, 0x11, 0xe6, 0xd1, 0x30, 0xe8,
+	0x3e, 0xcc, 0x26, 0x8c, 0xf9, 0x82, 0x3f, 0x86, 0x9a, 0x1d, 0x11, 0xf7, 0xf2, 0x72, 0x34, 0xa5,
+	0x8c, 0x29, 0x26, 0xa0, 0xbf, 0x97, 0xa0, 0xc9, 0xfc, 0x6d, 0x3b, 0xc0, 0x7e, 0xa7, 0xc0, 0x64,
+	0x9b, 0x59, 0x35, 0x25, 0x67, 0x4b, 0x35, 0x75, 0xb6, 0xac, 0x82, 0x61, 0x7b, 0x6d, 0xa7, 0x6b,
+	0x91, 0x3d, 0xcd, 0x2f, 0x6a, 0x3c, 0x2c, 0x33, 0xbe, 0xa0, 0xcf, 0x60, 0x56, 0xd3, 0x79, 0xd8,
+	0x23, 0xf2, 0x16, 0x4c, 0xb1, 0xd9, 0xcc, 0x94, 0xb1, 0xf9, 0x4b, 0x89, 0xf9, 0xd1, 0x3f, 0x4b,
+	0xb2, 0x40, 0xef, 0x91, 0xf1, 0x0b, 0x98, 0x68, 0x9f, 0xb7, 0x1d, 0xa2, 0xac, 0x7c, 0x33, 0xd3,
+	0xca, 0x8a, 0xb5, 0x29, 0x89, 0x99, 0xb7, 0xd3, 0xc0, 0xef, 0x60, 0x8f, 0x7d, 0x51, 0x15, 0xb3,
+	0x8e, 0x32, 0x3e, 0x07, 0xb0, 0x08, 0xf1, 0x37, 0x3a, 0xd8, 0x96, 0xe1, 0x30, 0x90, 0xb9, 0x36,
+	0x81, 0xa5, 0x4c, 0x17, 0x9f, 0x6d, 0x12, 0x3f, 0xea, 0x70, 0xbb, 0xd6, 0xcc, 0x18, 0x46, 0x7f,
+	0x8a, 0x0f, 0xd2, 0x7b, 0xb6, 0x48, 0x6b, 0x06, 0x54, 0x4f, 0x6c, 0xcf, 0x92, 0x76, 0xe2, 0xe3,
+	0xfe, 0xb7, 0x84, 0x64, 0x7f, 0x2b, 0xfa, 0xfe, 0x7e, 0x02, 0x35, 0xe6, 0xbc, 0xea, 0xba, 0x9b,
+	0x2b, 0xc4, 0xc4, 0x0c, 0x74, 0x00, 0xc6, 0xba, 0x87, 0x9d, 0xf3, 0xef, 0x89, 0x5e, 0x1f, 0xfc,
+	0x1a, 0xa6, 0x8e, 0x84, 0x7e, 0x97, 0xbb, 0x70, 0x6a, 0x29, 0x66, 0x3c, 0x07, 0xb5, 0x61, 0xe6,
+	0xa1, 0xde, 0x5b, 0x60, 0xab, 0xf4, 0x92, 0x3b, 0x1f, 0x1f, 0xb3, 0x8d, 0xb0, 0x48, 0xd8, 0x0e,
+	0x6c, 0x7e, 0xd4, 0xc8, 0x95, 0xea, 0xa8, 0x74, 0xef, 0xa3, 0xd2, 0xd3, 0xfb, 0x60, 0x25, 0x5f,
+	0x4a, 0x88, 0xbc, 0x0c, 0x7e, 0x01, 0xf5, 0x48, 0x61, 0x2e, 0x55, 0x3e, 0x35, 0xd9, 0x4c, 0x26,
+	0xa1, 0xdf, 0xc2, 0xf4, 0x33, 0x12, 0x88, 0xfc, 0x2a, 0xaf, 0x73, 0xa7, 0x02, 0x96, 0xfa, 0x2b,
+	0x90, 0xb9, 0x79, 0x9b, 0xba, 0xae, 0x1d, 0x17, 0x67, 0x02, 0x62, 0x8a, 0x1f, 0x76, 0x6d, 0xc7,
+	0xda, 0x4c, 0x9a, 0x2d, 0x09, 0x82, 0x7d, 0x3d, 0xa6, 0x52, 0x82, 0x8c, 0xbc, 0x04, 0x81, 0xfe,
+	0x53, 0x8a, 0x6f, 0x82, 0xc1, 0x7e, 0x84, 0xa3, 0x2e, 0x4f, 0xbf, 0x11, 0xf1, 0xb0, 0x17, 0x47,
+	0x93, 0x80, 0x18, 0xbe, 0xa3, 0xb7, 0x7d, 0x24, 0xc4, 0xf0, 0xac, 0x15, 0x43, 0x2c, 0x2e, 0x7b,
+	0xca, 0x94, 0x10, 0xf3, 0x1e, 0xc2, 0xfb, 0x35, 0x42, 0xa8, 0x00, 0x98, 0xbf, 0x1e, 0xc9, 0x1a,
+	0x46, 0xc6, 0x78, 0x0c, 0xb3, 0xd4, 0xe7, 0xf3, 0x3d, 0xe6, 0x05, 0xc0, 0x04, 0x97, 0xa2, 0x61,
+	0x58, 0xa6, 0x38, 0x0e, 0x68, 0xd7, 0xb7, 0xbd, 0xe3, 0xbd, 0x84, 0x6e, 0x92, 0xd3, 0x65, 0x7c,
+	0x41, 0x61, 0xdc, 0x13, 0x18, 0x71, 0x69, 0xda, 0xd5, 0xba, 0xd2, 0xf7, 0x6a, 0x5d, 0x4d, 0x5f,
+	0xad, 0xff, 0x5b, 0x82, 0xc6, 0x0e, 0xa7, 0x8a, 0x93, 0x53, 0xc8, 0xa5, 0x2b, 0x99, 0x02, 0x62,
+	0x17, 0x10, 0xb5, 0xcf, 0xe2, 0xde, 0x95, 0x7d, 0x01, 0xd1, 0x7d, 0x23, 0x71, 0x85, 0x75, 0xa8,
+	0xab, 0x64, 0xac, 0x72, 0x46, 0x76, 0x1c, 0xa6, 0xf7, 0xd6, 0x4c, 0x66, 0xb1, 0xa8, 0x93, 0xcd,
+	0x05, 0x15, 0xc9, 0x97, 0xf6, 0x31, 0x24, 0x83, 0x78, 0x0e, 0xfa, 0x02, 0x66, 0x4d, 0x82, 0x2d,
+	0xdb, 0x23, 0x61, 0x18, 0x57, 0xad, 0x01, 0xc1, 0x96, 0x6a, 0x43, 0x08, 0x80, 0x59, 0x2a, 0x20,
+	0x38, 0xa4, 0x9e, 0xca, 0x7e, 0x0a, 0x44, 0x2e, 0xcc, 0x8b, 0xcd, 0xda, 0xe8, 0x60, 0xef, 0x98,
+	0x6c, 0x9d, 0x12, 0xb1, 0x15, 0x99, 0x5b, 0x94, 0x71, 0x2a, 0x95, 0xfb, 0xde, 0x61, 0x5c, 0x12,
+	0x75, 0xa8, 0xa5, 0xce, 0x1f, 0x01, 0xa1, 0xff, 0x83, 0xc9, 0x87, 0x32, 0xb1, 0x35, 0xa1, 0x12,
+	0xe2, 0xb8, 0x43, 0x16, 0xe2, 0x73, 0xb4, 0x02, 0xcd, 0xfd, 0x28, 0x20, 0xd8, 0x65, 0xa9, 0x45,
+	0x1e, 0x84, 0x8b, 0x50, 0x6b, 0xd3, 0xae, 0xd4, 0xa4, 0x62, 0x0a, 0x00, 0xfd, 0x18, 0xe6, 0x35,
+	0xca, 0xd0, 0xa7, 0x5e, 0x48, 0xfa, 0x90, 0xde, 0x82, 0xea, 0x9e, 0x2a, 0x26, 0xa3, 0x80, 0x9e,
+	0x10, 0xf9, 0x59, 0x42, 0xfc, 0x3b, 0xed, 0xff, 0x7d, 0xed, 0x87, 0x5f, 0xc2, 0xc4, 0x06, 0xdf,
+	0x05, 0xe3, 0x15, 0x34, 0xb4, 0x76, 0xa9, 0xf1, 0xa3, 0x7e, 0x3d, 0xc6, 0x9e, 0x86, 0xee, 0xd2,
+	0xff, 0x0f, 0x26, 0x64, 0xb7, 0xf7, 0x6b, 0xc6, 0x4b, 0x80, 0xa4, 0xd9, 0x66, 0xdc, 0xcd, 0xd7,
+	0xc3, 0x5c, 0xfa, 0x60, 0x20, 0x9d, 0xe0, 0xde, 0x96, 0x3d, 0x9a, 0x54, 0x3b, 0xcd, 0xc8, 0xf6,
+	0x78, 0xbd, 0xbb, 0xb1, 0xb4, 0x32, 0xb8, 0xb7, 0x16, 0x2a, 0x21, 0x87, 0x30, 0xad, 0x77, 0xc7,
+	0x8c, 0xec, 0xb9, 0x19, 0xdd, 0xb7, 0xa5, 0xbb, 0x39, 0x28, 0x53, 0x0b, 0x49, 0x1f, 0x05, 0xa3,
+	0x2f, 0x24, 0xe3, 0x48, 0x41, 0xd7, 0x8c, 0x3d, 0x98, 0x94, 0xdb, 0x63, 0x5c, 0x1a, 0xd5, 0x8a,
+	0xf7, 0xad, 0x4c, 0xa2, 0xb8, 0x89, 0x84, 0xae, 0x19, 0x8f, 0x61, 0x42, 0xf4, 0xe6, 0x8c, 0xec,
+	0x20, 0x4f, 0x35, 0xee, 0x96, 0xde, 0xef, 0x7f, 0xa4, 0x2b, 0x86, 0x5f, 0x43, 0x43, 0x6b, 0xba,
+	0xf5, 0xf1, 0xc7, 0x8b, 0x6d, 0xb9, 0xa5, 0xdb, 0x03, 0xab, 0x05, 0x74, 0xcd, 0x30, 0x01, 0x58,
+	0x8b, 0x47, 0x24, 0x87, 0x3c, 0xc6, 0x7d, 0xff, 0x32, 0x12, 0xc1, 0xf3, 0x18, 0x8c, 0x8b, 0x6d,
+	0x23, 0x63, 0x35, 0x5b, 0xef, 0x7e, 0xfd, 0xa5, 0x3c, 0x82, 0x4c, 0x80, 0x7d, 0x7c, 0x4a, 0x0a,
+	0x55, 0xfe, 0x35, 0x4c, 0xeb, 0xfd, 0xa6, 0x3e, 0x9e, 0x9d, 0xd1, 0x92, 0xea, 0x13, 0xa0, 0x3d,
+	0x3d, 0x26, 0x11, 0x3b, 0xbb, 0xee, 0x40, 0x09, 0x19, 0xcd, 0xa5, 0xa5, 0xbb, 0x39, 0x28, 0x85,
+	0x8c, 0x27, 0x50, 0x5f, 0xb7, 0x94, 0xe5, 0x2f, 0x2b, 0xf1, 0xf2, 0xfb, 0xf5, 0x33, 0x98, 0x5d,
+	0xb7, 0x2c, 0xd6, 0xad, 0x2f, 0x96, 0xef, 0x01, 0x4c, 0x9b, 0xc4, 0xa5, 0xa7, 0xa4, 0x50, 0xae,
+	0x2f, 0x60, 0x5e, 0x70, 0x2d, 0x5e, 0x61, 0x0b, 0x16, 0x05, 0xeb, 0x1e, 0x07, 0xff, 0x49, 0xe6,
+	0xcc, 0x6c, 0xe7, 0x1e, 0x2c, 0xc5, 0x86, 0x1b, 0x69, 0x29, 0xfa, 0x42, 0x8a, 0x15, 0x65, 0x42,
+	0x7d, 0x9b, 0x28, 0x6f, 0x5c, 0xee, 0x9b, 0x5e, 0x86, 0xca, 0x2b, 0x2f, 0x60, 0x76, 0x9b, 0x44,
+	0xc3, 0x1a, 0x3f, 0x17, 0x6b, 0x0b, 0xe6, 0xb7, 0x49, 0x74, 0x05, 0xe3, 0xe7, 0x92, 0x62, 0xc3,
+	0x75, 0x4d, 0xca, 0xa8, 0xc6, 0xcf, 0x69, 0xab, 0xf9, 0x75, 0xcb, 0xda, 0x4e, 0x15, 0xd5, 0x05,
+	0xf9, 0xea, 0xb7, 0x70, 0x5d, 0x05, 0xed, 0x58, 0xf8, 0xbf, 0x54, 0xb1, 0x30, 0x16, 0xee, 0x58,
+	0xc5, 0xc0, 0xf8, 0x16, 0xe0, 0xc0, 0x7b, 0xe9, 0x30, 0xeb, 0x91, 0x52, 0x6c, 0xa4, 0xbd, 0x81,
+	0xdb, 0x19, 0x41, 0x3d, 0x56, 0x91, 0x5f, 0xf1, 0x68, 0xe9, 0x11, 0x51, 0x50, 0x90, 0x63, 0x1e,
+	0x23, 0xa3, 0x6e, 0x4e, 0x2e, 0x11, 0x0e, 0xdc, 0xd0, 0xc2, 0xf0, 0x0a, 0x96, 0xca, 0x25, 0xed,
+	0x0d, 0xdc, 0xea, 0x0d, 0xfa, 0x71, 0x8b, 0x7c, 0xc6, 0x13, 0xe5, 0xba, 0xe3, 0xec, 0x8b, 0x97,
+	0xd0, 0x70, 0xf4, 0x3a, 0x26, 0x55, 0x35, 0xbe, 0x82, 0x05, 0xc1, 0x97, 0xaf, 0x22, 0x66, 0x9e,
+	0x7d, 0x49, 0xe9, 0x7d, 0x63, 0xc9, 0x23, 0xe0, 0x29, 0xcc, 0x08, 0x01, 0x8f, 0x0b, 0xd5, 0xfb,
+	0x5b, 0x30, 0x34, 0xbd, 0x1f, 0x8f, 0x4f, 0xed, 0xf5, 0xb6, 0x78, 0x08, 0x19, 0x87, 0xda, 0x8a,
+	0x77, 0x71, 0x6a, 0xef, 0x43, 0x43, 0xf0, 0x17, 0x9d, 0xcc, 0x62, 0x94, 0x7e, 0x09, 0x4d, 0x4d,
+	0x69, 0xc1, 0xb9, 0x38, 0x95, 0x9f, 0x40, 0x7d, 0x07, 0x87, 0x45, 0xd7, 0xa0, 0x3b, 0x38, 0x2c,
+	0xbe, 0xa4, 0x7b, 0x01, 0xf3, 0x3b, 0x38, 0x1c, 0xd7, 0x09, 0xac, 0x54, 0x1e, 0x0b, 0xff, 0xaf,
+	0x60, 0x8e, 0x6d, 0x0b, 0xdb, 0xbb, 0x7b, 0x34, 0x60, 0xaf, 0x5a, 0x46, 0xf6, 0x45, 0xa4, 0xe7,
+	0xc1, 0x2b, 0xcf, 0x0e, 0x0a, 0xde, 0x6c, 0x22, 0xe3, 0xcd, 0x26, 0x17, 0xc7, 0xfb, 0x4b, 0xbe,
+	0x95, 0x6c, 0xd2, 0x70, 0x6a, 0x0f, 0xb6, 0xc8, 0x97, 0xfc, 0xa2, 0x32, 0x0e, 0xce, 0x5f, 0xc3,
+	0xfc, 0x26, 0x71, 0x48, 0x44, 0xc6, 0xc1, 0xfc, 0x25, 0x18, 0x09, 0xf3, 0xb0, 0x68, 0xee, 0x07,
+	0x00, 0x82, 0x7b, 0xa1, 0x5c, 0x9f, 0x2a, 0xae, 0x57, 0xf6, 0x8d, 0xd4, 0x1d, 0xfc, 0x25, 0x34,
+	0x05, 0xdb, 0xe4, 0x2d, 0xaa, 0x4f, 0x9b, 0xec, 0xc2, 0x8b, 0x69, 0x0e, 0xa5, 0x5f, 0xc3, 0x22,
+	0xbb, 0x1b, 0xc7, 0x33, 0x95, 0xa9, 0x8b, 0x93, 0xd0, 0x86, 0x77, 0x7b, 0xf5, 0x2f, 0x5e, 0x88,
+	0x05, 0xad, 0x5e, 0x21, 0xe1, 0x38, 0xa4, 0xbc, 0xc3, 0xee, 0x86, 0xa3, 0x8b, 0xc8, 0x55, 0x04,
+	0xbd, 0x86, 0x45, 0x76, 0x54, 0x8c, 0xd1, 0x5a, 0x87, 0xf0, 0x6e, 0x4f, 0x9a, 0xdc, 0xf5, 0x36,
+	0xc5, 0x63, 0x69, 0x61, 0x29, 0x4d, 0xc8, 0xd0, 0xd3, 0x65, 0xf1, 0x32, 0x5e, 0xf3, 0x0b, 0x9d,
+	0x96, 0x7f, 0x86, 0x14, 0x91, 0xc7, 0x79, 0x6f, 0x5c, 0x48, 0x72, 0x85, 0x0b, 0x71, 0xe0, 0x66,
+	0xa6, 0x5b, 0xc5, 0x82, 0x0a, 0x75, 0xaf, 0xb8, 0xe6, 0x13, 0xcc, 0x8b, 0x2a, 0x9f, 0x8e, 0xf8,
+	0x7e, 0xef, 0xba, 0xbe, 0x63, 0xb7, 0xed, 0x51, 0x8e, 0xe0, 0x3b, 0x03, 0x7e, 0x3e, 0x20, 0xe5,
+	0x78, 0x70, 0x53, 0x93, 0x73, 0x85, 0x58, 0x1c, 0x49, 0x9e, 0xf2, 0xe7, 0x11, 0x72, 0x71, 0x4e,
+	0x79, 0xaf, 0x60, 0x4e, 0xf4, 0x53, 0xe3, 0xdf, 0x01, 0xf4, 0xa9, 0x42, 0x7b, 0x7f, 0x3f, 0xb1,
+	0x74, 0x67, 0x10, 0x99, 0x72, 0xe9, 0xd9, 0xf4, 0xef, 0x0c, 0xf2, 0xf2, 0xbf, 0xe4, 0x59, 0xe3,
+	0x82, 0x90, 0x6f, 0x60, 0x26, 0xf5, 0xe0, 0x9e, 0xc7, 0xc9, 0xb2, 0x7b, 0xf9, 0x17, 0xdf, 0xed,
+	0xc5, 0xb3, 0x82, 0x78, 0xea, 0xcc, 0xc3, 0x37, 0xfb, 0x7e, 0xaf, 0x3d, 0x95, 0xf2, 0xa0, 0xa8,
+	0xc7, 0x8f, 0x8a, 0x79, 0x78, 0xf6, 0xb1, 0x75, 0xea, 0x5d, 0x92, 0x57, 0xfd, 0x93, 0xf2, 0x1d,
+	0x35, 0x0f, 0xd3, 0xc1, 0x0f, 0xb1, 0xe8, 0xda, 0xe1, 0x04, 0xff, 0xdf, 0x8a, 0x8f, 0xfe, 0x37,
+	0x00, 0x18, 0x05, 0x12, 0x2b, 0x78, 0x31, 0x00, 0x00,
 }
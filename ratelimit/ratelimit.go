@@ -0,0 +1,121 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit implements token bucket rate limits.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Bucket is a token bucket holding up to burst tokens, refilled at rate tokens per second.
+// It is safe for concurrent use.
+type Bucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewBucket creates a full bucket.
+func NewBucket(rate float64, burst int) *Bucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &Bucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// Allow takes a token from the bucket, it reports false if the bucket is empty.
+func (b *Bucket) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(time.Now())
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
+
+// full determines whether the bucket has refilled completely, so that it can be dropped
+// and created again without changing the limit.
+func (b *Bucket) full(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	return b.tokens >= b.burst
+}
+
+func (b *Bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+}
+
+// Limiter holds a bucket per key, e.g. per caller, created on first use.
+type Limiter struct {
+	rate  float64
+	burst int
+
+	mu      sync.Mutex
+	buckets map[string]*Bucket
+	swept   time.Time
+}
+
+// NewLimiter creates a limiter whose buckets have the given rate and burst.
+// A rate of 0 or less disables the limit.
+func NewLimiter(rate float64, burst int) *Limiter {
+	return &Limiter{rate: rate, burst: burst, buckets: map[string]*Bucket{}, swept: time.Now()}
+}
+
+// Allow takes a token from the bucket of key.
+func (l *Limiter) Allow(key string) bool {
+	if l == nil || l.rate <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if now.Sub(l.swept) > time.Minute {
+		l.sweep(now)
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = NewBucket(l.rate, l.burst)
+		l.buckets[key] = b
+	}
+	l.mu.Unlock()
+
+	return b.Allow()
+}
+
+// sweep drops the full buckets, the keys not seen for a while.
+func (l *Limiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if b.full(now) {
+			delete(l.buckets, key)
+		}
+	}
+	l.swept = now
+}
@@ -0,0 +1,142 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/server"
+)
+
+// abacPrefix marks the Enforce params holding an ABAC JSON object.
+const abacPrefix = "ABAC::"
+
+// Sizes bounds the requests, 0 means no limit.
+type Sizes struct {
+	// MaxParams is the number of values of a repeated string or map field, e.g. the
+	// params of a PolicyRequest.
+	MaxParams int `yaml:"maxParams" json:"maxParams"`
+	// MaxParamBytes is the length of a string field or of a value of a repeated field.
+	MaxParamBytes int `yaml:"maxParamBytes" json:"maxParamBytes"`
+	// MaxJSONBytes is the length of an ABAC JSON object passed to Enforce.
+	MaxJSONBytes int `yaml:"maxJSONBytes" json:"maxJSONBytes"`
+	// MaxBatchItems is the number of messages of a repeated field, e.g. the filters
+	// of LoadFilteredPolicy.
+	MaxBatchItems int `yaml:"maxBatchItems" json:"maxBatchItems"`
+	// MaxBatchBytes is the length of a document, the data of ImportPolicy, the model
+	// text of NewEnforcer or the connection string of NewAdapter, which holds the whole
+	// policy with the text driver.
+	MaxBatchBytes int `yaml:"maxBatchBytes" json:"maxBatchBytes"`
+}
+
+// SizeWrapper is a handler wrapper rejecting the requests over the sizes with the
+// StatusRequestTooLarge code and the ErrorIDTooLarge id.
+func SizeWrapper(sizes Sizes) server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			if err := sizes.check(req.Request()); err != nil {
+				return errors.New(ErrorIDTooLarge, err.Error(), StatusRequestTooLarge)
+			}
+
+			return fn(ctx, req, rsp)
+		}
+	}
+}
+
+// check walks the fields of a request message.
+func (s Sizes) check(msg interface{}) error {
+	v := reflect.ValueOf(msg)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.PkgPath != "" || strings.HasPrefix(field.Name, "XXX_") {
+			continue
+		}
+		name := field.Name
+		f := v.Field(i)
+
+		switch f.Kind() {
+		case reflect.String:
+			limit := s.MaxParamBytes
+			if name == "Data" || name == "ModelText" || name == "ConnectString" {
+				limit = s.MaxBatchBytes
+			}
+			if err := checkLength(name, f.Len(), limit, "bytes"); err != nil {
+				return err
+			}
+		case reflect.Map:
+			if err := checkLength(name, f.Len(), s.MaxParams, "values"); err != nil {
+				return err
+			}
+			for _, key := range f.MapKeys() {
+				if err := checkLength(name, f.MapIndex(key).Len(), s.MaxParamBytes, "bytes per value"); err != nil {
+					return err
+				}
+			}
+		case reflect.Slice:
+			if f.Type().Elem().Kind() != reflect.String {
+				if err := checkLength(name, f.Len(), s.MaxBatchItems, "items"); err != nil {
+					return err
+				}
+				for j := 0; j < f.Len(); j++ {
+					if err := s.check(f.Index(j).Interface()); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			if err := checkLength(name, f.Len(), s.MaxParams, "values"); err != nil {
+				return err
+			}
+			for j := 0; j < f.Len(); j++ {
+				value := f.Index(j).String()
+				limit := s.MaxParamBytes
+				if strings.HasPrefix(value, abacPrefix) {
+					limit = s.MaxJSONBytes
+				}
+				if err := checkLength(name, len(value), limit, "bytes per value"); err != nil {
+					return err
+				}
+			}
+		case reflect.Ptr:
+			if err := s.check(f.Interface()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkLength(name string, n int, limit int, unit string) error {
+	if limit > 0 && n > limit {
+		return fmt.Errorf("%s is too large: %d %s, the maximum is %d", name, n, unit, limit)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/cicdi-go/casbin/proto/casbin"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/server"
+)
+
+func TestSizesCheck(t *testing.T) {
+	sizes := Sizes{MaxParams: 3, MaxParamBytes: 8, MaxJSONBytes: 32, MaxBatchItems: 2, MaxBatchBytes: 64}
+
+	tests := []struct {
+		name string
+		msg  interface{}
+		ok   bool
+	}{
+		{"params", &pb.EnforceRequest{Params: []string{"alice", "data1", "read"}}, true},
+		{"too many params", &pb.EnforceRequest{Params: []string{"a", "b", "c", "d"}}, false},
+		{"long param", &pb.EnforceRequest{Params: []string{strings.Repeat("a", 9)}}, false},
+		{"abac param", &pb.EnforceRequest{Params: []string{`ABAC::{"Owner": "alice"}`}}, true},
+		{"long abac param", &pb.EnforceRequest{Params: []string{"ABAC::" + strings.Repeat("a", 32)}}, false},
+		{"json without prefix", &pb.EnforceRequest{Params: []string{`{"Owner": "alice"}`}}, false},
+		{"model text", &pb.NewEnforcerRequest{ModelText: strings.Repeat("m", 64)}, true},
+		{"long model text", &pb.NewEnforcerRequest{ModelText: strings.Repeat("m", 65)}, false},
+		{"connect string", &pb.NewAdapterRequest{DriverName: "text", ConnectString: strings.Repeat("p", 64)}, true},
+		{"long connect string", &pb.NewAdapterRequest{DriverName: "text", ConnectString: strings.Repeat("p", 65)}, false},
+		{"too many adapter params", &pb.NewAdapterRequest{Params: map[string]string{"a": "", "b": "", "c": "", "d": ""}}, false},
+		{"filters", &pb.LoadFilteredPolicyRequest{Filters: []*pb.PolicyFilter{{}, {}}}, true},
+		{"too many filters", &pb.LoadFilteredPolicyRequest{Filters: []*pb.PolicyFilter{{}, {}, {}}}, false},
+	}
+
+	for _, tt := range tests {
+		if err := sizes.check(tt.msg); (err == nil) != tt.ok {
+			t.Errorf("%s: got %v", tt.name, err)
+		}
+	}
+}
+
+func TestSizeWrapperErrorID(t *testing.T) {
+	w := SizeWrapper(Sizes{MaxParams: 1})(func(ctx context.Context, req server.Request, rsp interface{}) error {
+		return nil
+	})
+
+	err, ok := w(context.Background(), request{msg: &pb.EnforceRequest{Params: []string{"alice", "data1"}}}, nil).(*errors.Error)
+	if !ok || err.Code != StatusRequestTooLarge || err.Id != ErrorIDTooLarge {
+		t.Errorf("got %v, want a %s error", err, ErrorIDTooLarge)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+
+	"github.com/cicdi-go/casbin/auth"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/metadata"
+	"github.com/micro/go-micro/server"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// StatusTooManyRequests is the error code of the calls over a rate limit.
+	StatusTooManyRequests = 429
+	// StatusRequestTooLarge is the error code of the calls over a size limit.
+	StatusRequestTooLarge = 413
+)
+
+// The ids of the micro errors of the wrappers, which clients can rely on.
+const (
+	// ErrorIDRateLimited is the id of the errors for calls over a rate limit, code 429.
+	ErrorIDRateLimited = "casbin.rate_limited"
+	// ErrorIDTooLarge is the id of the errors for requests over a size limit, code 413.
+	ErrorIDTooLarge = "casbin.too_large"
+)
+
+// Rate is the limit of a token bucket.
+type Rate struct {
+	// PerSecond is the sustained number of calls per second, 0 or less for no limit.
+	PerSecond float64 `yaml:"perSecond" json:"perSecond"`
+	// Burst is the number of calls allowed at once.
+	Burst int `yaml:"burst" json:"burst"`
+}
+
+// Options configures the rate limit wrapper.
+type Options struct {
+	// Caller limits the calls of every caller.
+	Caller Rate
+	// Methods limits the calls of every caller to an RPC, by RPC name.
+	Methods map[string]Rate
+	// PrincipalKey is the metadata key naming unauthenticated callers for the default Key,
+	// auth.DefaultPrincipalKey by default.
+	PrincipalKey string
+	// Key identifies the caller of an RPC. By default it is the tenant and the subject of the
+	// authenticated principal, else the PrincipalKey metadata, else the address of the
+	// caller; only the callers of a tenant without any of them share a limit.
+	Key func(ctx context.Context, req server.Request) string
+}
+
+// Option sets an option of the rate limit wrapper.
+type Option func(*Options)
+
+// Caller sets the limit of every caller.
+func Caller(r Rate) Option {
+	return func(o *Options) {
+		o.Caller = r
+	}
+}
+
+// Methods sets the limits of every caller to specific RPCs.
+func Methods(rates map[string]Rate) Option {
+	return func(o *Options) {
+		o.Methods = rates
+	}
+}
+
+// PrincipalKey sets the metadata key naming unauthenticated callers, empty to ignore it.
+func PrincipalKey(key string) Option {
+	return func(o *Options) {
+		o.PrincipalKey = key
+	}
+}
+
+// Key sets the function identifying the caller of an RPC.
+func Key(fn func(ctx context.Context, req server.Request) string) Option {
+	return func(o *Options) {
+		o.Key = fn
+	}
+}
+
+// RemoteKey is the metadata key holding the address of the caller, set by the go-micro server.
+const RemoteKey = "Remote"
+
+func callerKey(principalKey string) func(ctx context.Context, req server.Request) string {
+	return func(ctx context.Context, req server.Request) string {
+		key := auth.TenantFromContext(ctx) + "/"
+		if sub := auth.Subject(ctx, principalKey); sub != "" {
+			return key + sub
+		}
+		if addr := remoteHost(ctx); addr != "" {
+			return key + "addr:" + addr
+		}
+		return key
+	}
+}
+
+// remoteHost gets the host of the address of the caller, its port changes with every connection.
+func remoteHost(ctx context.Context) string {
+	md, ok := metadata.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	addr, ok := md[RemoteKey]
+	if !ok {
+		for k, v := range md {
+			if strings.EqualFold(k, RemoteKey) {
+				addr = v
+				break
+			}
+		}
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// Wrapper is a handler wrapper limiting the rate of calls per caller and per caller and
+// RPC. Calls over a limit fail with the StatusTooManyRequests code, clients should back off.
+func Wrapper(opts ...Option) server.HandlerWrapper {
+	options := Options{PrincipalKey: auth.DefaultPrincipalKey}
+	for _, o := range opts {
+		o(&options)
+	}
+	if options.Key == nil {
+		options.Key = callerKey(options.PrincipalKey)
+	}
+
+	callers := NewLimiter(options.Caller.PerSecond, options.Caller.Burst)
+	methods := map[string]*Limiter{}
+	for method, r := range options.Methods {
+		methods[method] = NewLimiter(r.PerSecond, r.Burst)
+	}
+
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			method := req.Method()
+			if i := strings.LastIndex(method, "."); i != -1 {
+				method = method[i+1:]
+			}
+			key := options.Key(ctx, req)
+
+			if !callers.Allow(key) {
+				return errors.New(ErrorIDRateLimited, "rate limit exceeded", StatusTooManyRequests)
+			}
+			if l, ok := methods[method]; ok && !l.Allow(key) {
+				return errors.New(ErrorIDRateLimited, "rate limit of "+method+" exceeded", StatusTooManyRequests)
+			}
+
+			return fn(ctx, req, rsp)
+		}
+	}
+}
+
+// LoadMethodRates reads a YAML or JSON file mapping RPC names to rates.
+func LoadMethodRates(path string) (map[string]Rate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := map[string]Rate{}
+	if err := yaml.Unmarshal(data, &rates); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return rates, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cicdi-go/casbin/auth"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/metadata"
+	"github.com/micro/go-micro/server"
+)
+
+// serverRequest names the embedded interface apart from its Request method.
+type serverRequest = server.Request
+
+// request is an Enforce call of msg.
+type request struct {
+	serverRequest
+
+	msg interface{}
+}
+
+func (r request) Service() string {
+	return "test"
+}
+
+func (r request) Method() string {
+	return "Casbin.Enforce"
+}
+
+func (r request) Request() interface{} {
+	return r.msg
+}
+
+func TestCallerKey(t *testing.T) {
+	key := callerKey(auth.DefaultPrincipalKey)
+	acme := auth.NewTenantContext(context.Background(), "acme")
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"principal", auth.NewContext(acme, &auth.Principal{Subject: "alice"}), "acme/alice"},
+		{"principal metadata", metadata.NewContext(acme, metadata.Metadata{auth.DefaultPrincipalKey: "bob", RemoteKey: "10.0.0.1:5000"}), "acme/bob"},
+		{"remote address", metadata.NewContext(acme, metadata.Metadata{RemoteKey: "10.0.0.1:5000"}), "acme/addr:10.0.0.1"},
+		{"nothing", acme, "acme/"},
+	}
+
+	for _, tt := range tests {
+		if got := key(tt.ctx, nil); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWrapperErrorID(t *testing.T) {
+	w := Wrapper(Caller(Rate{PerSecond: 1, Burst: 1}))(func(ctx context.Context, req server.Request, rsp interface{}) error {
+		return nil
+	})
+
+	ctx := metadata.NewContext(context.Background(), metadata.Metadata{auth.DefaultPrincipalKey: "alice"})
+	if err := w(ctx, request{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	err, ok := w(ctx, request{}, nil).(*errors.Error)
+	if !ok || err.Code != StatusTooManyRequests || err.Id != ErrorIDRateLimited {
+		t.Errorf("got %v, want a %s error", err, ErrorIDRateLimited)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Mask replaces the redacted credentials.
+const Mask = "*****"
+
+var (
+	// scheme://user:password@ in URLs.
+	urlRegex = regexp.MustCompile(`(\w[\w+.-]*://)([^\s:/@]*):[^\s/@]*@`)
+	// user:password@protocol(address)/ and user:password@/ in MySQL data source names.
+	mysqlRegex = regexp.MustCompile(`(^|\s)([^\s:/@()]+):\S*@(\w*\(|/)`)
+	// password=value in key=value data source names and URL queries.
+	passwordRegex = regexp.MustCompile(`(?i)\b(password|passwd|pwd)=('[^']*'|[^\s;&]*)`)
+)
+
+// RedactDSN masks the password of a connection string.
+func RedactDSN(dsn string) string {
+	dsn = urlRegex.ReplaceAllString(dsn, "${1}${2}:"+Mask+"@")
+	dsn = mysqlRegex.ReplaceAllString(dsn, "${1}${2}:"+Mask+"@${3}")
+	return passwordRegex.ReplaceAllString(dsn, "${1}="+Mask)
+}
+
+// Redactor masks credentials in text: the secret values it was given and the
+// passwords of connection strings. It is safe for concurrent use.
+type Redactor struct {
+	mu     sync.RWMutex
+	values []string
+}
+
+// NewRedactor creates a Redactor knowing no secret value yet.
+func NewRedactor() *Redactor {
+	return &Redactor{}
+}
+
+// Add registers values to mask, empty values are ignored.
+func (r *Redactor) Add(values ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+
+		known := false
+		for _, v := range r.values {
+			if v == value {
+				known = true
+				break
+			}
+		}
+		if !known {
+			r.values = append(r.values, value)
+		}
+	}
+
+	// Mask the longest values first, in case one contains another.
+	sort.Slice(r.values, func(i, j int) bool {
+		return len(r.values[i]) > len(r.values[j])
+	})
+}
+
+// Redact masks the credentials found in s.
+func (r *Redactor) Redact(s string) string {
+	r.mu.RLock()
+	for _, value := range r.values {
+		s = strings.Replace(s, value, Mask, -1)
+	}
+	r.mu.RUnlock()
+
+	return RedactDSN(s)
+}
@@ -0,0 +1,182 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secret resolves the named credentials referenced by adapter connection strings
+// and redacts credentials from the text the service returns or logs.
+//
+// A connection string references a secret with a ${secret:name} placeholder, e.g.
+//
+//	root:${secret:mysql_password}@tcp(db:3306)/
+//
+// and the placeholder is replaced on the server with the value found by a Resolver.
+package secret
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+var placeholderRegex = regexp.MustCompile(`\$\{secret:([^}]*)\}`)
+
+// Resolver looks up the value of a named secret, ok is false if it does not know the name.
+type Resolver interface {
+	Resolve(name string) (value string, ok bool, err error)
+}
+
+// ResolverFunc is a function used as a Resolver.
+type ResolverFunc func(name string) (string, bool, error)
+
+// Resolve calls f(name).
+func (f ResolverFunc) Resolve(name string) (string, bool, error) {
+	return f(name)
+}
+
+// Env resolves a secret from the environment variable made of prefix and the name
+// upper-cased, the '/' of a name scoped with Scoped written "__", e.g. acme/mysql_password
+// is ACME__MYSQL_PASSWORD. So that two names never share a variable, Env only knows the
+// names whose parts are lowercase letters and digits joined by single underscores, it
+// leaves the others to the next resolvers of a Chain.
+func Env(prefix string) Resolver {
+	return ResolverFunc(func(name string) (string, bool, error) {
+		key, ok := envKey(name)
+		if !ok {
+			return "", false, nil
+		}
+
+		value, ok := os.LookupEnv(prefix + key)
+		return value, ok, nil
+	})
+}
+
+var envNameRegex = regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`)
+
+// envKey gets the variable of name without the prefix, ok is false for the names Env
+// does not know.
+func envKey(name string) (key string, ok bool) {
+	parts := strings.Split(name, "/")
+	for _, part := range parts {
+		if !envNameRegex.MatchString(part) {
+			return "", false
+		}
+	}
+
+	return strings.ToUpper(strings.Join(parts, "__")), true
+}
+
+// Dir resolves a secret from the file of the same name in dir, the layout of the secrets
+// mounted by Docker and Kubernetes. The trailing newline of the file is dropped. A name
+// scoped with Scoped, like tenant/name, is the file name of the subdirectory tenant.
+func Dir(dir string) Resolver {
+	return ResolverFunc(func(name string) (string, bool, error) {
+		for _, elem := range strings.Split(name, "/") {
+			if elem == "" || elem != filepath.Base(elem) || strings.HasPrefix(elem, ".") {
+				return "", false, fmt.Errorf("invalid secret name %q", name)
+			}
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if os.IsNotExist(err) {
+			return "", false, nil
+		}
+		if err != nil {
+			return "", false, err
+		}
+
+		return strings.TrimRight(string(data), "\r\n"), true, nil
+	})
+}
+
+// File resolves secrets from a YAML or JSON file mapping names to values, read once.
+func File(path string) (Resolver, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := map[string]string{}
+	if err := yaml.Unmarshal(data, &secrets); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return ResolverFunc(func(name string) (string, bool, error) {
+		value, ok := secrets[name]
+		return value, ok, nil
+	}), nil
+}
+
+// Chain resolves a secret with the first resolver that knows its name.
+func Chain(resolvers ...Resolver) Resolver {
+	return ResolverFunc(func(name string) (string, bool, error) {
+		for _, r := range resolvers {
+			value, ok, err := r.Resolve(name)
+			if err != nil || ok {
+				return value, ok, err
+			}
+		}
+		return "", false, nil
+	})
+}
+
+// Scoped resolves the secret name with r as scope/name, so that the callers of a scope,
+// e.g. a tenant, can only reference their own secrets. An empty scope leaves names as they are.
+func Scoped(r Resolver, scope string) Resolver {
+	if scope == "" {
+		return r
+	}
+
+	return ResolverFunc(func(name string) (string, bool, error) {
+		return r.Resolve(scope + "/" + name)
+	})
+}
+
+// Contains tells whether s has a ${secret:name} placeholder.
+func Contains(s string) bool {
+	return placeholderRegex.MatchString(s)
+}
+
+// Expand replaces every ${secret:name} placeholder of s with the value of the secret,
+// it also gets the values used so that they can be redacted.
+func Expand(s string, r Resolver) (string, []string, error) {
+	var values []string
+	var err error
+
+	res := placeholderRegex.ReplaceAllStringFunc(s, func(placeholder string) string {
+		if err != nil {
+			return ""
+		}
+
+		name := placeholderRegex.FindStringSubmatch(placeholder)[1]
+		value, ok, e := r.Resolve(name)
+		switch {
+		case e != nil:
+			err = fmt.Errorf("secret %s: %v", name, e)
+		case !ok:
+			err = fmt.Errorf("secret %s not found", name)
+		default:
+			values = append(values, value)
+		}
+		return value
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return res, values, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExpand(t *testing.T) {
+	r := ResolverFunc(func(name string) (string, bool, error) {
+		value, ok := map[string]string{"password": "s3cret", "acme/password": "acme-s3cret"}[name]
+		return value, ok, nil
+	})
+
+	tests := []struct {
+		name   string
+		r      Resolver
+		s      string
+		want   string
+		values []string
+		ok     bool
+	}{
+		{"no placeholder", r, "root@tcp(db:3306)/", "root@tcp(db:3306)/", nil, true},
+		{"placeholder", r, "root:${secret:password}@tcp(db:3306)/", "root:s3cret@tcp(db:3306)/", []string{"s3cret"}, true},
+		{"unknown", r, "root:${secret:other}@tcp(db:3306)/", "", nil, false},
+		{"scoped", Scoped(r, "acme"), "root:${secret:password}@/", "root:acme-s3cret@/", []string{"acme-s3cret"}, true},
+		{"other scope", Scoped(r, "other"), "root:${secret:password}@/", "", nil, false},
+	}
+
+	for _, tt := range tests {
+		got, values, err := Expand(tt.s, tt.r)
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: got error %v", tt.name, err)
+			continue
+		}
+		if got != tt.want || len(values) != len(tt.values) || (len(values) > 0 && values[0] != tt.values[0]) {
+			t.Errorf("%s: got %q %v, want %q %v", tt.name, got, values, tt.want, tt.values)
+		}
+	}
+}
+
+func TestContains(t *testing.T) {
+	if !Contains("p, ${secret:password}, data1, read") {
+		t.Error("placeholder not found")
+	}
+	if Contains("p, alice, data1, read") {
+		t.Error("placeholder found in a plain string")
+	}
+}
+
+func TestDirScoped(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.Mkdir(filepath.Join(dir, "acme"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "acme", "password"), []byte("acme-s3cret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "password"), []byte("s3cret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	r := Scoped(Dir(dir), "acme")
+	if value, ok, err := r.Resolve("password"); err != nil || !ok || value != "acme-s3cret" {
+		t.Errorf("got %q %v %v", value, ok, err)
+	}
+
+	for _, name := range []string{"../password", "acme/../password", ".hidden", "acme//password"} {
+		if _, _, err := Dir(dir).Resolve(name); err == nil {
+			t.Errorf("%s: invalid name accepted", name)
+		}
+	}
+}
+
+func TestEnvScoped(t *testing.T) {
+	env := map[string]string{
+		"TEST_SECRET_PASSWORD":       "s3cret",
+		"TEST_SECRET_ACME__X_Y":      "acme-s3cret",
+		"TEST_SECRET_ACME_X__Y":      "acme-x-s3cret",
+		"TEST_SECRET_ACME__PASSWORD": "acme-password",
+	}
+	for key, value := range env {
+		os.Setenv(key, value)
+		defer os.Unsetenv(key)
+	}
+
+	tests := []struct {
+		scope string
+		name  string
+		want  string
+		ok    bool
+	}{
+		{"", "password", "s3cret", true},
+		{"acme", "password", "acme-password", true},
+		{"acme", "x_y", "acme-s3cret", true},
+		// acme_x/y must not read the secret x_y of acme.
+		{"acme_x", "y", "acme-x-s3cret", true},
+		{"acme_x", "x_y", "", false},
+		{"acme", "X_Y", "", false},
+		{"acme", "x__y", "", false},
+		{"acme", "x-y", "", false},
+		{"acme_", "_x_y", "", false},
+		{"Acme", "password", "", false},
+	}
+
+	for _, tt := range tests {
+		value, ok, err := Scoped(Env("TEST_SECRET_"), tt.scope).Resolve(tt.name)
+		if err != nil || ok != tt.ok || value != tt.want {
+			t.Errorf("%s/%s: got %q %v %v, want %q %v", tt.scope, tt.name, value, ok, err, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package version describes the build of the service, set at build time with
+//
+//	go build -ldflags "-X github.com/cicdi-go/casbin/version.Version=v1.2.0 -X github.com/cicdi-go/casbin/version.Commit=$(git rev-parse --short HEAD)"
+//
+// as the Makefile does.
+package version
+
+var (
+	// Version is the version of the build, "latest" for development builds.
+	Version = "latest"
+	// Commit is the git commit the build is from.
+	Commit = "unknown"
+	// BuildDate is when the build was made, in RFC 3339 format.
+	BuildDate = "unknown"
+)